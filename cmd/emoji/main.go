@@ -0,0 +1,246 @@
+// Command emoji looks up and converts emojis for shell scripts.
+//
+//	emoji parse                      replaces aliases (:rocket:) of stdin with emojis
+//	emoji demojize                   replaces emojis of stdin with aliases
+//	emoji find rocket                searches emojis by aliases, names and keywords
+//	emoji info 🚀                    prints code points, name, group and aliases of the emoji
+//	emoji flag tr                    prints the country flag of ISO 3166 Alpha2 code
+//	emoji list [-group NAME]         lists emojis of all groups or the given group
+//	emoji tone thumbs_up dark        prints the emoji with skin tones
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/enescakir/emoji"
+)
+
+const usage = `Usage: emoji <command> [arguments]
+
+Commands:
+  parse [text]               replace aliases (:rocket:) with emojis, reads stdin without text
+  demojize [text]            replace emojis with aliases, reads stdin without text
+  find <query>               search emojis by aliases, names and keywords
+  info <emoji|alias>         print code points, name, group and aliases of the emoji
+  flag <code>                print the country flag of ISO 3166 Alpha2 code
+  list [-group name]         list emojis of all groups or the given group
+  tone <alias> <tone>...     print the emoji with skin tones, e.g. tone thumbs_up dark
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs the command with the arguments and returns the exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return 2
+	}
+
+	var err error
+	switch cmd, args := args[0], args[1:]; cmd {
+	case "parse":
+		err = convert(args, stdin, stdout, emoji.Parse)
+	case "demojize":
+		err = convert(args, stdin, stdout, emoji.Demojize)
+	case "find":
+		err = find(args, stdout)
+	case "info":
+		err = info(args, stdout)
+	case "flag":
+		err = countryFlag(args, stdout)
+	case "list":
+		err = list(args, stdout, stderr)
+	case "tone":
+		err = tone(args, stdout)
+	case "help", "-h", "-help", "--help":
+		fmt.Fprint(stdout, usage)
+		return 0
+	default:
+		fmt.Fprintf(stderr, "unknown command: %q\n\n%s", cmd, usage)
+		return 2
+	}
+
+	if err != nil {
+		fmt.Fprintf(stderr, "emoji: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
+
+// convert writes the converted text of the arguments, or the converted stdin line by line.
+func convert(args []string, stdin io.Reader, stdout io.Writer, fn func(string) string) error {
+	if len(args) > 0 {
+		_, err := fmt.Fprintln(stdout, fn(strings.Join(args, " ")))
+		return err
+	}
+
+	reader := bufio.NewReader(stdin)
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			if _, werr := io.WriteString(stdout, fn(line)); werr != nil {
+				return werr
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// find writes the search results of the query.
+func find(args []string, stdout io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("find needs a query")
+	}
+
+	results := emoji.Search(strings.Join(args, " "))
+	if len(results) == 0 {
+		return fmt.Errorf("no emoji found: %q", strings.Join(args, " "))
+	}
+
+	for _, r := range results {
+		fmt.Fprintf(stdout, "%s\t%s\t%s\n", r.Code, r.Alias, r.Name)
+	}
+
+	return nil
+}
+
+// info writes the details of the emoji that is given by its unicode representation or alias.
+func info(args []string, stdout io.Writer) error {
+	if len(args) != 1 {
+		return fmt.Errorf("info needs an emoji or alias")
+	}
+
+	code, err := lookup(args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "Emoji:       %s\n", code)
+	fmt.Fprintf(stdout, "Code points: %U\n", emoji.Emoji(code))
+	if name, ok := emoji.Name(code, ""); ok {
+		fmt.Fprintf(stdout, "Name:        %s\n", name)
+	}
+	if group, subgroup, ok := emoji.GroupOf(code); ok {
+		fmt.Fprintf(stdout, "Group:       %s / %s\n", group, subgroup)
+	}
+	if version, ok := emoji.Version(code); ok {
+		fmt.Fprintf(stdout, "Version:     %s\n", version)
+	}
+	if aliases := emoji.Aliases(code); len(aliases) > 0 {
+		fmt.Fprintf(stdout, "Aliases:     %s\n", strings.Join(aliases, " "))
+	}
+
+	return nil
+}
+
+// countryFlag writes the country flag of the code.
+func countryFlag(args []string, stdout io.Writer) error {
+	if len(args) != 1 {
+		return fmt.Errorf("flag needs a country code")
+	}
+
+	flag, err := emoji.CountryFlag(args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(stdout, flag)
+
+	return nil
+}
+
+// list writes the emojis of the groups with their aliases.
+func list(args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	group := fs.String("group", "", "name of the emoji group, e.g. \"Food & Drink\"")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	groups := emoji.Groups()
+	if *group != "" {
+		g, ok := emoji.FindGroup(*group)
+		if !ok {
+			return fmt.Errorf("unknown group: %q", *group)
+		}
+		groups = []emoji.Group{g}
+	}
+
+	for _, g := range groups {
+		for _, s := range g.Subgroups {
+			for _, code := range s.Emojis {
+				alias, _ := emoji.FindAlias(code)
+				fmt.Fprintf(stdout, "%s\t%s\t%s\t%s\n", code, alias, g.Name, s.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// tone writes the emoji of the alias with the skin tones.
+func tone(args []string, stdout io.Writer) error {
+	if len(args) < 2 {
+		return fmt.Errorf("tone needs an alias and skin tones")
+	}
+
+	code, err := lookup(args[0])
+	if err != nil {
+		return err
+	}
+
+	var e emoji.TonedEmoji
+	if err = e.UnmarshalText([]byte(code)); err != nil {
+		return err
+	}
+
+	tones := make([]emoji.Tone, len(args)-1)
+	for i, name := range args[1:] {
+		if tones[i], err = emoji.ParseTone(name); err != nil {
+			return err
+		}
+	}
+
+	toned, err := e.Emoji.CheckedTone(tones...)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(stdout, toned)
+
+	return nil
+}
+
+// lookup returns the emoji code of the input that is an emoji or an alias. Colons of the alias are optional.
+func lookup(input string) (string, error) {
+	var e emoji.Emoji
+	if err := e.UnmarshalText([]byte(input)); err == nil && e != "" {
+		return e.String(), nil
+	}
+
+	alias := input
+	if !strings.HasPrefix(alias, ":") {
+		alias = ":" + alias + ":"
+	}
+
+	if code, ok := emoji.Find(alias); ok {
+		return code, nil
+	}
+
+	return "", fmt.Errorf("emoji not found: %q", input)
+}
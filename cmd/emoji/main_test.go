@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tt := []struct {
+		args     []string
+		stdin    string
+		expected string
+	}{
+		{
+			args:     []string{"parse"},
+			stdin:    "deploy :rocket:\nship it :+1:\n",
+			expected: "deploy \U0001F680\nship it \U0001F44D\n",
+		},
+		{
+			args:     []string{"parse", "I", "like", "a", ":pizza:"},
+			expected: "I like a \U0001F355\n",
+		},
+		{
+			args:     []string{"demojize"},
+			stdin:    "deploy \U0001F680",
+			expected: "deploy :rocket:",
+		},
+		{
+			args:     []string{"flag", "tr"},
+			expected: "\U0001F1F9\U0001F1F7\n",
+		},
+		{
+			args:     []string{"tone", "thumbs_up", "dark"},
+			expected: "\U0001F44D\U0001F3FF\n",
+		},
+		{
+			args:     []string{"tone", ":people_holding_hands:", "light", "medium"},
+			expected: "\U0001F9D1\U0001F3FB\u200d\U0001F91D\u200d\U0001F9D1\U0001F3FD\n",
+		},
+		{
+			args: []string{"info", "\U0001F680"},
+			expected: "Emoji:       \U0001F680\n" +
+				"Code points: U+1F680\n" +
+				"Name:        rocket\n" +
+				"Group:       Travel & Places / transport-air\n" +
+				"Version:     0.6\n" +
+				"Aliases:     :rocket:\n",
+		},
+	}
+
+	for i, tc := range tt {
+		var stdout, stderr bytes.Buffer
+		code := run(tc.args, strings.NewReader(tc.stdin), &stdout, &stderr)
+		if code != 0 {
+			t.Fatalf("test case %v fail: exit code: %v, stderr: %v", i+1, code, stderr.String())
+		}
+
+		if got := stdout.String(); got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestRunFind(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"find", "rocket"}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("test case fail: exit code: %v, stderr: %v", code, stderr.String())
+	}
+
+	expected := "\U0001F680\t:rocket:\trocket\n"
+	if got := stdout.String(); !strings.HasPrefix(got, expected) {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, expected)
+	}
+}
+
+func TestRunList(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"list", "-group", "Food & Drink"}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("test case fail: exit code: %v, stderr: %v", code, stderr.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	expected := "\U0001F347\t:grapes:\tFood & Drink\tfood-fruit"
+	if lines[0] != expected {
+		t.Fatalf("test case fail: got: %v, expected: %v", lines[0], expected)
+	}
+
+	for i, line := range lines {
+		if !strings.Contains(line, "\tFood & Drink\t") {
+			t.Fatalf("test case %v fail: not in the group: %v", i+1, line)
+		}
+	}
+}
+
+func TestRunError(t *testing.T) {
+	tt := []struct {
+		args     []string
+		expected int
+	}{
+		{args: nil, expected: 2},
+		{args: []string{"unknown"}, expected: 2},
+		{args: []string{"find"}, expected: 1},
+		{args: []string{"find", "zzzzzz"}, expected: 1},
+		{args: []string{"info", "not_exist_emoji"}, expected: 1},
+		{args: []string{"flag", "tur"}, expected: 1},
+		{args: []string{"list", "-group", "Not Exist"}, expected: 1},
+		{args: []string{"tone", "rocket", "dark"}, expected: 1},
+		{args: []string{"tone", "thumbs_up", "purple"}, expected: 1},
+		{args: []string{"tone", "thumbs_up", "dark", "light", "medium"}, expected: 1},
+	}
+
+	for i, tc := range tt {
+		var stdout, stderr bytes.Buffer
+		code := run(tc.args, strings.NewReader(""), &stdout, &stderr)
+		if code != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, code, tc.expected)
+		}
+
+		if stderr.Len() == 0 {
+			t.Fatalf("test case %v fail: expected error message", i+1)
+		}
+	}
+}
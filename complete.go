@@ -0,0 +1,314 @@
+package emoji
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Match scores of a completion query rune
+const (
+	runeScore        = 1
+	consecutiveScore = 2
+	wordStartScore   = 3
+)
+
+var (
+	// aliasCompletions is the index of aliases for autocomplete and fuzzy search.
+	// It's built from the emojis map on first use.
+	aliasCompletions     *completions
+	aliasCompletionsOnce sync.Once
+)
+
+// Candidate defines an emoji alias that matches an autocomplete or fuzzy search query.
+type Candidate struct {
+	// Alias is the matched alias, e.g. ":pizza:"
+	Alias string
+	// Code is the unicode representation of the emoji
+	Code string
+	// Canonical is the shortest alias of the emoji
+	Canonical string
+	// Score is the relevance of the alias for the query. Higher is better.
+	Score int
+}
+
+// completions indexes aliases by their names, that are aliases without colons in lowercase.
+type completions struct {
+	// names are sorted for prefix search
+	names []string
+	// runes are the map of runes and the names that contain the rune
+	runes map[rune][]string
+	// aliases are the map of names and their aliases
+	aliases map[string]string
+}
+
+// completionIndex returns the completion index of aliases.
+func completionIndex() *completions {
+	aliasCompletionsOnce.Do(func() {
+		aliasCompletions = &completions{
+			runes:   make(map[rune][]string),
+			aliases: make(map[string]string),
+		}
+		for alias := range emojiMap {
+			aliasCompletions.add(alias)
+		}
+		sort.Strings(aliasCompletions.names)
+	})
+
+	return aliasCompletions
+}
+
+// insert adds the alias to the index and keeps the names sorted.
+func (c *completions) insert(alias string) {
+	if !c.add(alias) {
+		return
+	}
+
+	name := completionName(alias)
+	i := sort.SearchStrings(c.names[:len(c.names)-1], name)
+	copy(c.names[i+1:], c.names[i:len(c.names)-1])
+	c.names[i] = name
+}
+
+// add appends the alias to the index without sorting, and reports whether it's a new alias.
+func (c *completions) add(alias string) bool {
+	name := completionName(alias)
+	if _, ok := c.aliases[name]; ok || name == "" {
+		return false
+	}
+
+	c.aliases[name] = alias
+	c.names = append(c.names, name)
+
+	seen := make(map[rune]bool)
+	for _, r := range name {
+		if !seen[r] {
+			seen[r] = true
+			c.runes[r] = append(c.runes[r], name)
+		}
+	}
+
+	return true
+}
+
+// candidate returns the candidate of the name with the score.
+func (c *completions) candidate(name string, score int) Candidate {
+	alias := c.aliases[name]
+	code := emojiMap[alias]
+	canonical, _ := FindAlias(code)
+
+	return Candidate{Alias: alias, Code: code, Canonical: canonical, Score: score}
+}
+
+// Complete returns emojis whose aliases start with the prefix, e.g. ":piz" or "piz".
+// Each emoji is returned once with its best matching alias. Shorter aliases rank higher.
+// At most limit candidates are returned, or all of them if limit is not positive.
+func Complete(prefix string, limit int) []Candidate {
+	query := completionName(prefix)
+	if query == "" {
+		return nil
+	}
+
+	index := completionIndex()
+
+	var candidates []Candidate
+	for i := sort.SearchStrings(index.names, query); i < len(index.names); i++ {
+		name := index.names[i]
+		if !strings.HasPrefix(name, query) {
+			break
+		}
+
+		score, _ := fuzzyScore(name, query)
+		candidates = append(candidates, index.candidate(name, score))
+	}
+
+	return rankCandidates(candidates, limit)
+}
+
+// FuzzySearch returns emojis whose aliases contain the runes of the query in order, e.g. "thup" for ":thumbsup:".
+// Matches at word starts and runs of consecutive matches rank higher.
+// Each emoji is returned once with its best matching alias.
+// At most limit candidates are returned, or all of them if limit is not positive.
+func FuzzySearch(query string, limit int) []Candidate {
+	query = completionName(query)
+	if query == "" {
+		return nil
+	}
+
+	index := completionIndex()
+
+	// only the names that contain the rarest rune of the query can match
+	var names []string
+	for i, r := range query {
+		list, ok := index.runes[r]
+		if !ok {
+			return nil
+		}
+
+		if i == 0 || len(list) < len(names) {
+			names = list
+		}
+	}
+
+	var candidates []Candidate
+	for _, name := range names {
+		if score, ok := fuzzyScore(name, query); ok {
+			candidates = append(candidates, index.candidate(name, score))
+		}
+	}
+
+	return rankCandidates(candidates, limit)
+}
+
+// fuzzyScore returns the match score of the name for the query,
+// and reports whether the name contains the runes of the query in order.
+// Consecutive matches score more as the run of matches gets longer.
+func fuzzyScore(name, query string) (int, bool) {
+	q := []rune(query)
+
+	score, matched, last, run := 0, 0, -2, 0
+	var prev rune
+	for i, r := range []rune(name) {
+		if matched == len(q) {
+			break
+		}
+
+		if r == q[matched] {
+			score += runeScore
+			if i == last+1 {
+				run++
+				score += run * consecutiveScore
+			} else {
+				run = 0
+			}
+			if i == 0 || prev == '_' || prev == '-' {
+				score += wordStartScore
+			}
+			last = i
+			matched++
+		}
+		prev = r
+	}
+
+	if name == query {
+		score += exactScore
+	}
+
+	return score, matched == len(q)
+}
+
+// rankCandidates sorts candidates by their scores, shorter aliases first for the same scores,
+// and keeps the best candidate of each emoji.
+func rankCandidates(candidates []Candidate, limit int) []Candidate {
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if len(a.Alias) != len(b.Alias) {
+			return len(a.Alias) < len(b.Alias)
+		}
+
+		return a.Alias < b.Alias
+	})
+
+	var ranked []Candidate
+	seen := make(map[string]bool)
+	for _, c := range candidates {
+		if seen[c.Code] {
+			continue
+		}
+		seen[c.Code] = true
+
+		ranked = append(ranked, c)
+		if len(ranked) == limit {
+			break
+		}
+	}
+
+	return ranked
+}
+
+// completionName returns the alias without colons in lowercase.
+func completionName(alias string) string {
+	return strings.ToLower(strings.Trim(alias, ":"))
+}
+
+// Suggest returns the nearest aliases to the unknown alias by edit distance, e.g. ":thumbsup:" for ":thumbsup_:".
+// Insertions, deletions, substitutions and transpositions of adjacent runes are single edits,
+// and aliases that need more edits than a third of the alias length are not suggested.
+// Each emoji is suggested once with its nearest alias. At most n aliases are returned, or all of them if n is not positive.
+func Suggest(alias string, n int) []string {
+	query := []rune(completionName(alias))
+	if len(query) == 0 {
+		return nil
+	}
+
+	maxDistance := len(query) / 3
+	if maxDistance < 1 {
+		maxDistance = 1
+	}
+
+	index := completionIndex()
+
+	var candidates []Candidate
+	for _, name := range index.names {
+		r := []rune(name)
+		if diff := len(r) - len(query); diff > maxDistance || -diff > maxDistance {
+			continue
+		}
+
+		if d := editDistance(query, r); d <= maxDistance {
+			// nearer aliases have higher scores to rank them like other candidates
+			candidates = append(candidates, index.candidate(name, -d))
+		}
+	}
+
+	var suggestions []string
+	for _, c := range rankCandidates(candidates, n) {
+		suggestions = append(suggestions, c.Alias)
+	}
+
+	return suggestions
+}
+
+// editDistance returns the optimal string alignment distance of a and b,
+// that is Levenshtein distance with transpositions of adjacent runes.
+func editDistance(a, b []rune) int {
+	// rows are the last three rows of the distance matrix
+	rows := [3][]int{make([]int, len(b)+1), make([]int, len(b)+1), make([]int, len(b)+1)}
+	for j := range rows[1] {
+		rows[1][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		prev2, prev, cur := rows[0], rows[1], rows[2]
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			d := minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d = minInt(d, prev2[j-2]+1)
+			}
+			cur[j] = d
+		}
+		rows[0], rows[1], rows[2] = prev, cur, prev2
+	}
+
+	return rows[1][len(b)]
+}
+
+// minInt returns the minimum of the integers.
+func minInt(x int, ys ...int) int {
+	for _, y := range ys {
+		if y < x {
+			x = y
+		}
+	}
+
+	return x
+}
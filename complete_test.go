@@ -0,0 +1,182 @@
+package emoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestComplete(t *testing.T) {
+	tt := []struct {
+		input    string
+		limit    int
+		expected []Candidate
+	}{
+		{
+			input: ":piz",
+			limit: 5,
+			expected: []Candidate{
+				{Alias: ":pizza:", Code: Pizza.String(), Canonical: ":pizza:"},
+			},
+		},
+		{
+			input: "THUMBS",
+			limit: 0,
+			expected: []Candidate{
+				{Alias: ":thumbsup:", Code: ThumbsUp.String(), Canonical: ":+1:"},
+				{Alias: ":thumbsdown:", Code: ThumbsDown.String(), Canonical: ":-1:"},
+			},
+		},
+		{
+			input: ":rocket:",
+			limit: 1,
+			expected: []Candidate{
+				{Alias: ":rocket:", Code: Rocket.String(), Canonical: ":rocket:"},
+			},
+		},
+		{input: ":", limit: 5, expected: nil},
+		{input: "qqq", limit: 5, expected: nil},
+	}
+
+	for i, tc := range tt {
+		got := Complete(tc.input, tc.limit)
+		if !equalCandidates(got, tc.expected) {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestCompleteLimit(t *testing.T) {
+	got := Complete("s", 10)
+	if len(got) != 10 {
+		t.Fatalf("test case fail: got: %v, expected: %v", len(got), 10)
+	}
+
+	seen := make(map[string]bool)
+	for i, c := range got {
+		if seen[c.Code] {
+			t.Fatalf("test case %v fail: duplicate emoji: %v", i+1, c)
+		}
+		seen[c.Code] = true
+
+		if i > 0 && got[i-1].Score < c.Score {
+			t.Fatalf("test case %v fail: not ranked: %v", i+1, got)
+		}
+	}
+}
+
+func TestFuzzySearch(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected Candidate
+	}{
+		{input: "thup", expected: Candidate{Alias: ":thumbsup:", Code: ThumbsUp.String(), Canonical: ":+1:"}},
+		{input: "pza", expected: Candidate{Alias: ":pizza:", Code: Pizza.String(), Canonical: ":pizza:"}},
+		{input: ":rocket:", expected: Candidate{Alias: ":rocket:", Code: Rocket.String(), Canonical: ":rocket:"}},
+		{input: "tada", expected: Candidate{Alias: ":tada:", Code: PartyPopper.String(), Canonical: ":tada:"}},
+	}
+
+	for i, tc := range tt {
+		got := FuzzySearch(tc.input, 3)
+		if len(got) == 0 || !equalCandidates(got[:1], []Candidate{tc.expected}) {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestFuzzySearchNoResult(t *testing.T) {
+	tt := []string{"", "::", "qqqqq", "çç"}
+
+	for i, query := range tt {
+		if got := FuzzySearch(query, 5); len(got) != 0 {
+			t.Fatalf("test case %v fail: got: %v, expected no result", i+1, got)
+		}
+	}
+}
+
+func TestCompleteAppendAlias(t *testing.T) {
+	if err := AppendAlias(":zzz_custom_sleep:", Zzz.String()); err != nil {
+		t.Fatalf("test case fail: %v", err)
+	}
+
+	got := Complete(":zzz_cus", 5)
+	expected := []Candidate{{Alias: ":zzz_custom_sleep:", Code: Zzz.String(), Canonical: ":zzz:"}}
+	if !equalCandidates(got, expected) {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, expected)
+	}
+
+	if got := FuzzySearch("zzcsl", 1); !equalCandidates(got, expected) {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, expected)
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	tt := []struct {
+		input    string
+		n        int
+		expected []string
+	}{
+		{input: ":thumbsup_:", n: 3, expected: []string{":thumbsup:"}},
+		{input: ":rokcet:", n: 3, expected: []string{":rocket:"}},
+		{input: "piza", n: 1, expected: []string{":pizza:"}},
+		{input: ":tda:", n: 0, expected: []string{":tea:", ":tada:"}},
+		{input: ":xyzzy_qq:", n: 3, expected: nil},
+		{input: "::", n: 3, expected: nil},
+	}
+
+	for i, tc := range tt {
+		got := Suggest(tc.input, tc.n)
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tt := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "rocket", b: "rocket", expected: 0},
+		{a: "rokcet", b: "rocket", expected: 1},
+		{a: "piza", b: "pizza", expected: 1},
+		{a: "pizzas", b: "pizza", expected: 1},
+		{a: "kitten", b: "sitting", expected: 3},
+		{a: "", b: "tea", expected: 3},
+		{a: "çay", b: "cay", expected: 1},
+	}
+
+	for i, tc := range tt {
+		got := editDistance([]rune(tc.a), []rune(tc.b))
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+// equalCandidates compares candidates without their scores.
+func equalCandidates(a, b []Candidate) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Alias != b[i].Alias || a[i].Code != b[i].Code || a[i].Canonical != b[i].Canonical {
+			return false
+		}
+	}
+
+	return true
+}
+
+func BenchmarkComplete(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_ = Complete(":piz", 10)
+	}
+}
+
+func BenchmarkFuzzySearch(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_ = FuzzySearch("thup", 10)
+	}
+}
@@ -5,6 +5,9 @@ package emoji
 // Source: https://unicode.org/Public/emoji/13.0/emoji-test.txt
 // Create at: 2020-03-08T15:58:37+03:00
 
+// UnicodeVersion is the Unicode Emoji version that the emoji constants are generated from.
+const UnicodeVersion = "13.0"
+
 var (
 
 	// GROUP: Smileys & Emotion
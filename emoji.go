@@ -2,7 +2,9 @@ package emoji
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"sync"
 )
 
 // Base attributes
@@ -21,6 +23,26 @@ const (
 	Dark        Tone = "\U0001F3FF"
 )
 
+var (
+	// skinTones is the list of skin tones except the default one.
+	skinTones = []Tone{Light, MediumLight, Medium, MediumDark, Dark}
+
+	// toneNames is the map of skin tones and their names.
+	toneNames = map[Tone]string{
+		Default:     "default",
+		Light:       "light",
+		MediumLight: "medium-light",
+		Medium:      "medium",
+		MediumDark:  "medium-dark",
+		Dark:        "dark",
+	}
+
+	// tonedEmojis is the map of all renderings of emojis with skin tones.
+	// It's built from the emojiWithTones map on first use.
+	tonedEmojis     map[string]TonedEmoji
+	tonedEmojisOnce sync.Once
+)
+
 // Emoji defines an emoji object with no skin variations.
 type Emoji string
 
@@ -29,11 +51,19 @@ func (e Emoji) String() string {
 	return string(e)
 }
 
+// Format implements fmt.Formatter for the simple emoji.
+// Besides the string verbs, %x and %X print the code points in hex (1f680),
+// %U prints them in Unicode format (U+1F680), and %a prints the alias of the emoji.
+func (e Emoji) Format(f fmt.State, verb rune) {
+	formatEmoji(f, verb, e.String(), "+-# 0")
+}
+
 // EmojiWithTone defines an emoji object that has skin tone options.
 type EmojiWithTone struct {
-	oneTonedCode string
-	twoTonedCode string
-	defaultTone  Tone
+	oneTonedCode  string
+	twoTonedCode  string
+	defaultTone   Tone
+	preferredTone Tone
 }
 
 // newEmojiWithTone constructs a new emoji object that has skin tone options.
@@ -62,12 +92,32 @@ func (e EmojiWithTone) withDefaultTone(tone string) EmojiWithTone {
 	return e
 }
 
+// WithPreferredTone returns the emoji that is formatted with the skin tone for the plus flag, e.g. %+v.
+// It's useful for rendering emojis with the skin tone that the user prefers.
+func (e EmojiWithTone) WithPreferredTone(tone Tone) EmojiWithTone {
+	e.preferredTone = tone
+
+	return e
+}
+
 // String returns string representation of the emoji with default skin tone.
 func (e EmojiWithTone) String() string {
 	return strings.ReplaceAll(e.oneTonedCode, TonePlaceholder, e.defaultTone.String())
 }
 
+// Format implements fmt.Formatter for the emoji with skin tone options.
+// It supports the verbs of Emoji. The plus flag renders the emoji with its preferred skin tone, e.g. %+v.
+func (e EmojiWithTone) Format(f fmt.State, verb rune) {
+	code := e.String()
+	if f.Flag('+') && e.preferredTone != "" {
+		code = e.Tone(e.preferredTone)
+	}
+
+	formatEmoji(f, verb, code, "-# 0")
+}
+
 // Tone returns string representation of the emoji with given skin tone.
+// Skin tones aren't validated, use CheckedTone to validate them.
 func (e EmojiWithTone) Tone(tones ...Tone) string {
 	// if no tone given, return with default skin tone
 	if len(tones) == 0 {
@@ -79,9 +129,11 @@ func (e EmojiWithTone) Tone(tones ...Tone) string {
 
 	// if one tone given or emoji doesn't have twoTonedCode, use oneTonedCode
 	// Also, replace all with one tone
-	if len(tones) == 1 {
+	// Same tones use oneTonedCode too, e.g. 👭🏻 is the RGI emoji of two women with light skin tones
+	if len(tones) == 1 || sameTones(tones) {
 		str = e.oneTonedCode
 		replaceCount = -1
+		tones = tones[:1]
 	}
 
 	// replace tone one by one
@@ -97,6 +149,96 @@ func (e EmojiWithTone) Tone(tones ...Tone) string {
 	return str
 }
 
+// sameTones checks whether all skin tones are the same.
+func sameTones(tones []Tone) bool {
+	for _, t := range tones[1:] {
+		if t != tones[0] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CheckedTone returns string representation of the emoji with given skin tones like Tone,
+// but it returns an error for unknown skin tones, more skin tones than the emoji takes,
+// or the default skin tone that is mixed with another skin tone.
+func (e EmojiWithTone) CheckedTone(tones ...Tone) (string, error) {
+	if slots := e.ToneSlots(); len(tones) > slots {
+		return "", fmt.Errorf("too many skin tones for the emoji, got %d, expected at most %d: %v", len(tones), slots, e)
+	}
+
+	defaults := 0
+	for _, t := range tones {
+		if _, ok := toneNames[t]; !ok {
+			return "", fmt.Errorf("not valid skin tone: %+q", t)
+		}
+
+		if t == Default {
+			defaults++
+		}
+	}
+
+	if defaults > 0 && defaults < len(tones) {
+		return "", fmt.Errorf("default skin tone can't be mixed with other skin tones: %v", e)
+	}
+
+	return e.Tone(tones...), nil
+}
+
+// ToneSlots returns the count of skin tones that the emoji takes.
+// It's 2 for emojis of two people that can have different skin tones, e.g. PeopleHoldingHands, and 1 for others.
+func (e EmojiWithTone) ToneSlots() int {
+	if strings.Count(e.twoTonedCode, TonePlaceholder) > 1 {
+		return 2
+	}
+
+	return 1
+}
+
+// Variants returns all renderings of the emoji with skin tones, e.g. for skin tone palettes.
+// The emoji with each skin tone comes first. Emojis with two tone slots have also
+// the renderings with each pair of different skin tones. The default rendering isn't included.
+func (e EmojiWithTone) Variants() []TonedEmoji {
+	var variants []TonedEmoji
+	for _, t := range skinTones {
+		variants = append(variants, TonedEmoji{Emoji: e, Tones: []Tone{t}})
+	}
+
+	if e.ToneSlots() < 2 {
+		return variants
+	}
+
+	for _, t1 := range skinTones {
+		for _, t2 := range skinTones {
+			if t1 != t2 {
+				variants = append(variants, TonedEmoji{Emoji: e, Tones: []Tone{t1, t2}})
+			}
+		}
+	}
+
+	return variants
+}
+
+// findEmojiWithTone returns the emoji that has skin tone options and the skin tones of the code.
+// The code can be the emoji with default tone or any skin tone rendering of it.
+func findEmojiWithTone(code string) (EmojiWithTone, []Tone, bool) {
+	tonedEmojisOnce.Do(func() {
+		tonedEmojis = make(map[string]TonedEmoji)
+		for _, e := range emojiWithTones {
+			tonedEmojis[e.String()] = TonedEmoji{Emoji: e}
+
+			for _, v := range e.Variants() {
+				tonedEmojis[v.String()] = v
+			}
+		}
+	})
+
+	toned, ok := tonedEmojis[code]
+
+	return toned.Emoji, toned.Tones, ok
+}
+
 // Tone defines skin tone options for emojis.
 type Tone string
 
@@ -105,6 +247,49 @@ func (t Tone) String() string {
 	return string(t)
 }
 
+// Name returns the name of the skin tone, e.g. "medium-dark". It's empty for unknown skin tones.
+func (t Tone) Name() string {
+	return toneNames[t]
+}
+
+// Tones returns all skin tones from Default to Dark.
+func Tones() []Tone {
+	return append([]Tone{Default}, skinTones...)
+}
+
+// ParseTone returns the skin tone by its name, e.g. "medium-dark".
+// Names are case insensitive, and underscores or spaces can be used instead of hyphens, e.g. "Medium_Dark".
+// Fitzpatrick scale numbers from "1" to "6" are accepted too.
+func ParseTone(name string) (Tone, error) {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	normalized = strings.NewReplacer("_", "-", " ", "-").Replace(normalized)
+
+	for tone, n := range toneNames {
+		if n == normalized {
+			return tone, nil
+		}
+	}
+
+	if len(normalized) == 1 && normalized[0] >= '1' && normalized[0] <= '6' {
+		return ToneFromFitzpatrick(int(normalized[0] - '0'))
+	}
+
+	return "", fmt.Errorf("not valid skin tone: %q", name)
+}
+
+// ToneFromFitzpatrick returns the skin tone of the Fitzpatrick scale type from 1 to 6.
+// Types 1 and 2 are both Light as the skin tone modifiers do.
+func ToneFromFitzpatrick(scale int) (Tone, error) {
+	switch {
+	case scale == 1:
+		return Light, nil
+	case scale >= 2 && scale <= 6:
+		return skinTones[scale-2], nil
+	default:
+		return "", fmt.Errorf("not valid Fitzpatrick scale: %d", scale)
+	}
+}
+
 // CountryFlag returns a country flag emoji from given country code.
 // Full list of country codes: https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2
 func CountryFlag(code string) (Emoji, error) {
@@ -118,6 +303,61 @@ func CountryFlag(code string) (Emoji, error) {
 	return Emoji(flag), nil
 }
 
+// formatEmoji writes the emoji code to the state for the verb.
+// Only the given flags of the state are passed to the string verbs.
+func formatEmoji(f fmt.State, verb rune, code string, flags string) {
+	switch verb {
+	case 'x', 'X', 'U':
+		fmt.Fprintf(f, formatDirective(f, 's', "-"), codePoints(code, verb))
+	case 'a':
+		// toned emojis are formatted like Demojize, e.g. :+1::skin-tone-6:
+		fmt.Fprintf(f, formatDirective(f, 's', "-"), demojize(code, code))
+	default:
+		fmt.Fprintf(f, formatDirective(f, verb, flags), code)
+	}
+}
+
+// formatDirective rebuilds the format directive of the state with the verb and given flags.
+func formatDirective(f fmt.State, verb rune, flags string) string {
+	var directive strings.Builder
+	directive.WriteRune('%')
+
+	for _, flag := range flags {
+		if f.Flag(int(flag)) {
+			directive.WriteRune(flag)
+		}
+	}
+
+	if width, ok := f.Width(); ok {
+		directive.WriteString(strconv.Itoa(width))
+	}
+
+	if precision, ok := f.Precision(); ok {
+		directive.WriteRune('.')
+		directive.WriteString(strconv.Itoa(precision))
+	}
+
+	directive.WriteRune(verb)
+
+	return directive.String()
+}
+
+// codePoints returns the code points of the emoji for the verb.
+// %x and %X print hex code points, %U prints Unicode format.
+func codePoints(code string, verb rune) string {
+	return hexCodePoints(code, "%"+string(verb), " ")
+}
+
+// hexCodePoints returns the code points of the code in the format joined by the separator.
+func hexCodePoints(code, format, sep string) string {
+	var points []string
+	for _, r := range code {
+		points = append(points, fmt.Sprintf(format, r))
+	}
+
+	return strings.Join(points, sep)
+}
+
 // countryCodeLetter shifts given letter byte as flagBaseIndex.
 func countryCodeLetter(l byte) string {
 	return string(rune(l) + flagBaseIndex)
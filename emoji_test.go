@@ -1,6 +1,8 @@
 package emoji
 
 import (
+	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -56,6 +58,7 @@ func TestEmojiWithTones(t *testing.T) {
 		{input: WomanAndManHoldingHands, tones: []Tone{}, expected: "\U0001f46b"},
 		{input: WomanAndManHoldingHands, tones: []Tone{MediumLight}, expected: "\U0001f46b\U0001F3FC"},
 		{input: WomanAndManHoldingHands, tones: []Tone{Medium, Dark}, expected: "\U0001f469\U0001F3FD\u200d\U0001f91d\u200d\U0001f468\U0001F3FF"},
+		{input: WomanAndManHoldingHands, tones: []Tone{Dark, Dark}, expected: "\U0001f46b\U0001F3FF"},
 	}
 
 	for i, tc := range tt {
@@ -66,6 +69,265 @@ func TestEmojiWithTones(t *testing.T) {
 	}
 }
 
+func TestEmojiWithCheckedTone(t *testing.T) {
+	tt := []struct {
+		input    EmojiWithTone
+		tones    []Tone
+		expected string
+	}{
+		{input: ThumbsUp, tones: nil, expected: ThumbsUp.String()},
+		{input: ThumbsUp, tones: []Tone{Dark}, expected: "\U0001F44D\U0001F3FF"},
+		{input: ThumbsUp, tones: []Tone{Default}, expected: ThumbsUp.String()},
+		{input: PeopleHoldingHands, tones: []Tone{Light, Dark}, expected: PeopleHoldingHands.Tone(Light, Dark)},
+		{input: PeopleHoldingHands, tones: []Tone{Default, Default}, expected: PeopleHoldingHands.String()},
+		{input: WomenHoldingHands, tones: []Tone{Light, Light}, expected: "\U0001F46D\U0001F3FB"},
+		{input: PeopleHoldingHands, tones: []Tone{Dark, Dark}, expected: PeopleHoldingHands.Tone(Dark)},
+	}
+
+	for i, tc := range tt {
+		got, err := tc.input.CheckedTone(tc.tones...)
+		if err != nil {
+			t.Fatalf("test case %v fail: %v", i+1, err)
+		}
+
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestEmojiWithCheckedToneError(t *testing.T) {
+	tt := []struct {
+		input EmojiWithTone
+		tones []Tone
+	}{
+		{input: ThumbsUp, tones: []Tone{Light, Dark}},
+		{input: PeopleHoldingHands, tones: []Tone{Light, Dark, Medium}},
+		{input: ThumbsUp, tones: []Tone{Tone("x")}},
+		{input: ThumbsUp, tones: []Tone{Tone("\U0001F3FF\U0001F3FF")}},
+		{input: PeopleHoldingHands, tones: []Tone{Default, Dark}},
+	}
+
+	for i, tc := range tt {
+		if got, err := tc.input.CheckedTone(tc.tones...); err == nil {
+			t.Fatalf("test case %v fail: got: %v, expected error", i+1, got)
+		}
+	}
+}
+
+func TestTones(t *testing.T) {
+	expected := []Tone{Default, Light, MediumLight, Medium, MediumDark, Dark}
+	if got := Tones(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, expected)
+	}
+
+	for i, tone := range Tones() {
+		got, err := ParseTone(tone.Name())
+		if err != nil || got != tone {
+			t.Fatalf("test case %v fail: got: %+q, %v, expected: %+q", i+1, got, err, tone)
+		}
+	}
+}
+
+func TestParseTone(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected Tone
+		err      bool
+	}{
+		{input: "medium-dark", expected: MediumDark},
+		{input: "Medium_Light", expected: MediumLight},
+		{input: " dark ", expected: Dark},
+		{input: "medium dark", expected: MediumDark},
+		{input: "default", expected: Default},
+		{input: "1", expected: Light},
+		{input: "5", expected: MediumDark},
+		{input: "7", err: true},
+		{input: "purple", err: true},
+		{input: "", err: true},
+	}
+
+	for i, tc := range tt {
+		got, err := ParseTone(tc.input)
+		if (err != nil) != tc.err || got != tc.expected {
+			t.Fatalf("test case %v fail: got: %+q, %v, expected: %+q", i+1, got, err, tc.expected)
+		}
+	}
+}
+
+func TestToneFromFitzpatrick(t *testing.T) {
+	tt := []struct {
+		input    int
+		expected Tone
+		err      bool
+	}{
+		{input: 1, expected: Light},
+		{input: 2, expected: Light},
+		{input: 3, expected: MediumLight},
+		{input: 4, expected: Medium},
+		{input: 5, expected: MediumDark},
+		{input: 6, expected: Dark},
+		{input: 0, err: true},
+		{input: 7, err: true},
+	}
+
+	for i, tc := range tt {
+		got, err := ToneFromFitzpatrick(tc.input)
+		if (err != nil) != tc.err || got != tc.expected {
+			t.Fatalf("test case %v fail: got: %+q, %v, expected: %+q", i+1, got, err, tc.expected)
+		}
+	}
+}
+
+func TestToneName(t *testing.T) {
+	tt := []struct {
+		input    Tone
+		expected string
+	}{
+		{input: Default, expected: "default"},
+		{input: MediumDark, expected: "medium-dark"},
+		{input: Tone("x"), expected: ""},
+	}
+
+	for i, tc := range tt {
+		if got := tc.input.Name(); got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestEmojiWithSameTonesRGI(t *testing.T) {
+	for alias, e := range emojiWithTones {
+		if e.ToneSlots() < 2 {
+			continue
+		}
+
+		for _, tone := range skinTones {
+			if got := e.Tone(tone, tone); !IsRGI(got) {
+				t.Fatalf("test case %v fail: got: %+q, expected an RGI emoji", alias, got)
+			}
+		}
+	}
+}
+
+func TestEmojiWithToneSlots(t *testing.T) {
+	tt := []struct {
+		input    EmojiWithTone
+		expected int
+	}{
+		{input: ThumbsUp, expected: 1},
+		{input: Technologist, expected: 1},
+		{input: PeopleHoldingHands, expected: 2},
+		{input: WomanAndManHoldingHands, expected: 2},
+	}
+
+	for i, tc := range tt {
+		got := tc.input.ToneSlots()
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestEmojiWithToneVariants(t *testing.T) {
+	tt := []struct {
+		input EmojiWithTone
+		count int
+		first string
+		last  string
+	}{
+		{input: ThumbsUp, count: 5, first: ThumbsUp.Tone(Light), last: ThumbsUp.Tone(Dark)},
+		{
+			input: PeopleHoldingHands,
+			count: 25,
+			first: PeopleHoldingHands.Tone(Light),
+			last:  PeopleHoldingHands.Tone(Dark, MediumDark),
+		},
+	}
+
+	for i, tc := range tt {
+		variants := tc.input.Variants()
+		if len(variants) != tc.count {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, len(variants), tc.count)
+		}
+
+		first, last := variants[0].String(), variants[len(variants)-1].String()
+		if first != tc.first || last != tc.last {
+			t.Fatalf("test case %v fail: got: %v %v, expected: %v %v", i+1, first, last, tc.first, tc.last)
+		}
+
+		seen := make(map[string]bool)
+		for j, v := range variants {
+			if seen[v.String()] || !IsRGI(v.String()) {
+				t.Fatalf("test case %v fail: not valid variant %v: %+q", i+1, j+1, v.String())
+			}
+			seen[v.String()] = true
+		}
+	}
+}
+
+func TestEmojiFormat(t *testing.T) {
+	tt := []struct {
+		format   string
+		input    interface{}
+		expected string
+	}{
+		{format: "%v", input: Rocket, expected: "\U0001F680"},
+		{format: "%s", input: Rocket, expected: "\U0001F680"},
+		{format: "%q", input: Rocket, expected: "\"\U0001F680\""},
+		{format: "%+q", input: Rocket, expected: `"\U0001f680"`},
+		{format: "%x", input: Rocket, expected: "1f680"},
+		{format: "%X", input: Rocket, expected: "1F680"},
+		{format: "%U", input: Rocket, expected: "U+1F680"},
+		{format: "%U", input: ManTechnologist, expected: "U+1F468 U+200D U+1F4BB"},
+		{format: "%a", input: Rocket, expected: ":rocket:"},
+		{format: "%a", input: ThumbsUp, expected: ":+1:"},
+		{format: "%a", input: Emoji("not emoji"), expected: "not emoji"},
+		{format: "%-10a|", input: Rocket, expected: ":rocket:  |"},
+		{format: "%3v|", input: Rocket, expected: "  \U0001F680|"},
+		{format: "%v", input: WavingHand, expected: "\U0001F44B"},
+		{format: "%x", input: WavingHand, expected: "1f44b"},
+		{format: "%a", input: WavingHand, expected: ":wave:"},
+		{format: "%+q", input: WavingHand, expected: "\"\U0001F44B\""},
+		{format: "%a", input: Emoji(ThumbsUp.Tone(Dark)), expected: ":+1::skin-tone-6:"},
+		{format: "%a", input: Emoji(PeopleHoldingHands.Tone(Light, Dark)), expected: ":people_holding_hands::skin-tone-2::skin-tone-6:"},
+	}
+
+	for i, tc := range tt {
+		got := fmt.Sprintf(tc.format, tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestEmojiWithToneFormatPreferredTone(t *testing.T) {
+	tt := []struct {
+		format   string
+		tone     Tone
+		expected string
+	}{
+		{format: "%+v", tone: "", expected: "\U0001F44B"},
+		{format: "%+v", tone: Default, expected: "\U0001F44B"},
+		{format: "%+v", tone: Dark, expected: "\U0001F44B\U0001F3FF"},
+		{format: "%v", tone: Dark, expected: "\U0001F44B"},
+		{format: "%+x", tone: Medium, expected: "1f44b 1f3fd"},
+		{format: "%+U", tone: Light, expected: "U+1F44B U+1F3FB"},
+		{format: "%+a", tone: Dark, expected: ":wave::skin-tone-6:"},
+	}
+
+	for i, tc := range tt {
+		got := fmt.Sprintf(tc.format, WavingHand.WithPreferredTone(tc.tone))
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+
+	if got := fmt.Sprintf("%+v", WavingHand); got != WavingHand.String() {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, WavingHand)
+	}
+}
+
 func TestCountryFlag(t *testing.T) {
 	tt := []struct {
 		input    string
@@ -0,0 +1,74 @@
+package emoji
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	// codeGroups is the map of basic emoji codes and their group and subgroup indexes.
+	// It's built from the emoji groups on first use.
+	codeGroups     map[string][2]int
+	codeGroupsOnce sync.Once
+)
+
+// Group is a Unicode emoji group, e.g. "Food & Drink".
+type Group struct {
+	Name      string
+	Subgroups []Subgroup
+}
+
+// Subgroup is a Unicode emoji subgroup, e.g. "food-fruit".
+type Subgroup struct {
+	Name string
+	// Emojis are the codes of the emojis in the subgroup.
+	// Skin tone variants aren't listed, only their basic emojis are.
+	Emojis []string
+}
+
+// Groups returns the Unicode emoji groups in the order of the emoji list.
+func Groups() []Group {
+	return emojiGroups
+}
+
+// FindGroup returns the emoji group by its name. Names are case insensitive.
+func FindGroup(name string) (Group, bool) {
+	for _, g := range emojiGroups {
+		if strings.EqualFold(g.Name, name) {
+			return g, true
+		}
+	}
+
+	return Group{}, false
+}
+
+// GroupOf returns the group and subgroup names of the emoji, e.g. "Food & Drink" and "food-prepared".
+// Emojis with skin tones are in the group of their basic emojis.
+func GroupOf(code string) (string, string, bool) {
+	codeGroupsOnce.Do(func() {
+		codeGroups = make(map[string][2]int)
+		for i, g := range emojiGroups {
+			for j, s := range g.Subgroups {
+				for _, c := range s.Emojis {
+					codeGroups[c] = [2]int{i, j}
+				}
+			}
+		}
+	})
+
+	index, ok := codeGroups[code]
+	if !ok {
+		emoji, _, toned := findEmojiWithTone(code)
+		if !toned {
+			return "", "", false
+		}
+
+		if index, ok = codeGroups[emoji.String()]; !ok {
+			return "", "", false
+		}
+	}
+
+	g := emojiGroups[index[0]]
+
+	return g.Name, g.Subgroups[index[1]].Name, true
+}
@@ -0,0 +1,61 @@
+package emoji
+
+import (
+	"testing"
+)
+
+func TestGroups(t *testing.T) {
+	groups := Groups()
+	if len(groups) == 0 || groups[0].Name != "Smileys & Emotion" {
+		t.Fatalf("test case fail: got: %v groups", len(groups))
+	}
+
+	for i, g := range groups {
+		if g.Name == "" || len(g.Subgroups) == 0 {
+			t.Fatalf("test case %v fail: empty group: %v", i+1, g.Name)
+		}
+	}
+}
+
+func TestFindGroup(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected string
+		exist    bool
+	}{
+		{input: "Food & Drink", expected: "Food & Drink", exist: true},
+		{input: "food & drink", expected: "Food & Drink", exist: true},
+		{input: "food-fruit", expected: "", exist: false},
+	}
+
+	for i, tc := range tt {
+		got, exist := FindGroup(tc.input)
+		if got.Name != tc.expected || exist != tc.exist {
+			t.Fatalf("test case %v fail: got: %v, %v, expected: %v, %v", i+1, got.Name, exist, tc.expected, tc.exist)
+		}
+	}
+}
+
+func TestGroupOf(t *testing.T) {
+	tt := []struct {
+		input    string
+		group    string
+		subgroup string
+		exist    bool
+	}{
+		{input: Pizza.String(), group: "Food & Drink", subgroup: "food-prepared", exist: true},
+		{input: Rocket.String(), group: "Travel & Places", subgroup: "transport-air", exist: true},
+		{input: ThumbsUp.String(), group: "People & Body", subgroup: "hand-fingers-closed", exist: true},
+		{input: ThumbsUp.Tone(Dark), group: "People & Body", subgroup: "hand-fingers-closed", exist: true},
+		{input: FlagForTurkey.String(), group: "Flags", subgroup: "country-flag", exist: true},
+		{input: "not emoji", exist: false},
+	}
+
+	for i, tc := range tt {
+		group, subgroup, exist := GroupOf(tc.input)
+		if group != tc.group || subgroup != tc.subgroup || exist != tc.exist {
+			t.Fatalf("test case %v fail: got: %v, %v, %v, expected: %v, %v, %v",
+				i+1, group, subgroup, exist, tc.group, tc.subgroup, tc.exist)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package emoji
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// ParseHTML replaces emoji aliases (:pizza:) with unicode representation like Parse,
+// escapes the text for HTML, and wraps each emoji in an accessible element with its CLDR name:
+//
+//	<span role="img" aria-label="pizza">🍕</span>
+func ParseHTML(input string) string {
+	return renderHTML(Parse(input), func(code, text string) string {
+		return fmt.Sprintf(`<span role="img" aria-label="%s">%s</span>`,
+			html.EscapeString(emojiLabel(code)), html.EscapeString(text))
+	})
+}
+
+// renderHTML escapes the text for HTML and replaces each emoji with the output of render.
+// render takes the emoji code and the emoji text as it's in the input.
+func renderHTML(input string, render func(code, text string) string) string {
+	var output strings.Builder
+
+	scanEmojis(input, func(part, code string) {
+		if code == "" {
+			output.WriteString(html.EscapeString(part))
+			return
+		}
+
+		output.WriteString(render(code, part))
+	})
+
+	return output.String()
+}
+
+// emojiLabel returns a human readable label of the emoji.
+// It's the CLDR name of the emoji, or its alias for custom emojis.
+func emojiLabel(code string) string {
+	if name, ok := emojiNames[code]; ok {
+		return name
+	}
+
+	if alias, ok := FindAlias(code); ok {
+		return strings.ReplaceAll(strings.Trim(alias, ":"), "_", " ")
+	}
+
+	return code
+}
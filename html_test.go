@@ -0,0 +1,64 @@
+package emoji
+
+import (
+	"testing"
+)
+
+func TestParseHTML(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "Hello :wave:",
+			expected: "Hello <span role=\"img\" aria-label=\"waving hand\">\U0001F44B</span>",
+		},
+		{
+			input:    "<b>\U0001F355 & :+1:</b>",
+			expected: "&lt;b&gt;<span role=\"img\" aria-label=\"pizza\">\U0001F355</span> &amp; <span role=\"img\" aria-label=\"thumbs up\">\U0001F44D</span>&lt;/b&gt;",
+		},
+		{
+			input:    "\U0001F44D\U0001F3FF",
+			expected: "<span role=\"img\" aria-label=\"thumbs up: dark skin tone\">\U0001F44D\U0001F3FF</span>",
+		},
+		{
+			input:    "I \u2764 Go",
+			expected: "I <span role=\"img\" aria-label=\"red heart\">\u2764</span> Go",
+		},
+		{
+			input:    "\"quoted\" :not_exist_emoji:",
+			expected: "&#34;quoted&#34; :not_exist_emoji:",
+		},
+	}
+
+	for i, tc := range tt {
+		got := ParseHTML(tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestEmojiLabel(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected string
+	}{
+		{input: Rocket.String(), expected: "rocket"},
+		{input: ManTechnologist.String(), expected: "man technologist"},
+		{input: "custom", expected: "custom"},
+	}
+
+	for i, tc := range tt {
+		got := emojiLabel(tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func BenchmarkParseHTML(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_ = ParseHTML("I am :man_technologist: from :flag_for_turkey:. Tests are :thumbs_up:")
+	}
+}
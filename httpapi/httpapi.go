@@ -0,0 +1,229 @@
+// Package httpapi serves the emoji API as JSON over HTTP for services that can't use the Go package.
+//
+// The handler can be mounted in an existing mux with a prefix:
+//
+//	mux.Handle("/emoji/", http.StripPrefix("/emoji", httpapi.NewHandler()))
+//
+// Endpoints:
+//
+//	GET|POST /parse?text=...       replaces aliases with emojis, POST reads the text from the body
+//	GET|POST /demojize?text=...    replaces emojis with aliases, POST reads the text from the body
+//	GET /search?q=...[&limit=N]    searches emojis by aliases, names and keywords, 20 results by default and 100 at most
+//	GET /emoji?alias=...           returns the emoji of the alias, colons are optional
+//	GET /emoji?code=...            returns the emoji of the unicode representation
+//	GET /flag?code=...             returns the country flag of ISO 3166 Alpha2 code
+//	GET /groups                    returns the emoji groups and their emojis
+package httpapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/enescakir/emoji"
+)
+
+const (
+	// maxBodySize is the size limit of request bodies.
+	maxBodySize = 1 << 20
+	// defaultSearchLimit is the number of search results if the limit isn't given.
+	defaultSearchLimit = 20
+	// maxSearchLimit is the maximum number of search results.
+	maxSearchLimit = 100
+)
+
+// Text is the response of parse and demojize endpoints.
+type Text struct {
+	Text string `json:"text"`
+}
+
+// Emoji is the response of emoji and flag endpoints, and an item of search results.
+type Emoji struct {
+	Code     string   `json:"code"`
+	Name     string   `json:"name,omitempty"`
+	Group    string   `json:"group,omitempty"`
+	Subgroup string   `json:"subgroup,omitempty"`
+	Version  string   `json:"version,omitempty"`
+	Aliases  []string `json:"aliases,omitempty"`
+}
+
+// SearchResult is an item of search results.
+type SearchResult struct {
+	Emoji
+	Score int `json:"score"`
+}
+
+// Group is an item of the groups response.
+type Group struct {
+	Name      string     `json:"name"`
+	Subgroups []Subgroup `json:"subgroups"`
+}
+
+// Subgroup is a subgroup of the emoji group.
+type Subgroup struct {
+	Name   string   `json:"name"`
+	Emojis []string `json:"emojis"`
+}
+
+// Error is the response of failed requests.
+type Error struct {
+	Error string `json:"error"`
+}
+
+// NewHandler returns the handler of the emoji API.
+func NewHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/parse", textHandler(emoji.Parse))
+	mux.HandleFunc("/demojize", textHandler(emoji.Demojize))
+	mux.HandleFunc("/search", get(search))
+	mux.HandleFunc("/emoji", get(lookup))
+	mux.HandleFunc("/flag", get(countryFlag))
+	mux.HandleFunc("/groups", get(groups))
+
+	return mux
+}
+
+// textHandler returns the handler that converts the text of the request with fn.
+func textHandler(fn func(string) string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var text string
+		switch r.Method {
+		case http.MethodGet:
+			text = r.URL.Query().Get("text")
+		case http.MethodPost:
+			b, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+			if err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Errorf("could not read body: %v", err))
+				return
+			}
+			text = string(b)
+		default:
+			w.Header().Set("Allow", "GET, POST")
+			writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method not allowed: %v", r.Method))
+			return
+		}
+
+		writeJSON(w, http.StatusOK, Text{Text: fn(text)})
+	}
+}
+
+// get returns the handler that accepts only GET requests.
+func get(fn http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method not allowed: %v", r.Method))
+			return
+		}
+
+		fn(w, r)
+	}
+}
+
+func search(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("missing query parameter: q"))
+		return
+	}
+
+	limit := defaultSearchLimit
+	if l := r.URL.Query().Get("limit"); l != "" {
+		n, err := strconv.Atoi(l)
+		if err != nil || n < 1 || n > maxSearchLimit {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("not valid limit, expected 1 to %d: %q", maxSearchLimit, l))
+			return
+		}
+		limit = n
+	}
+
+	results := []SearchResult{}
+	for _, res := range emoji.Search(query) {
+		results = append(results, SearchResult{Emoji: newEmoji(res.Code), Score: res.Score})
+		if len(results) == limit {
+			break
+		}
+	}
+
+	writeJSON(w, http.StatusOK, results)
+}
+
+func lookup(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var code string
+	switch {
+	case query.Get("alias") != "":
+		alias := query.Get("alias")
+		if !strings.HasPrefix(alias, ":") {
+			alias = ":" + alias + ":"
+		}
+
+		c, ok := emoji.Find(alias)
+		if !ok {
+			writeError(w, http.StatusNotFound, fmt.Errorf("emoji not found: %q", alias))
+			return
+		}
+		code = c
+	case query.Get("code") != "":
+		var e emoji.Emoji
+		if err := e.UnmarshalText([]byte(query.Get("code"))); err != nil {
+			writeError(w, http.StatusNotFound, err)
+			return
+		}
+		code = e.String()
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Errorf("missing query parameter: alias or code"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newEmoji(code))
+}
+
+func countryFlag(w http.ResponseWriter, r *http.Request) {
+	flag, err := emoji.CountryFlag(r.URL.Query().Get("code"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newEmoji(flag.String()))
+}
+
+func groups(w http.ResponseWriter, r *http.Request) {
+	var res []Group
+	for _, g := range emoji.Groups() {
+		group := Group{Name: g.Name}
+		for _, s := range g.Subgroups {
+			group.Subgroups = append(group.Subgroups, Subgroup{Name: s.Name, Emojis: s.Emojis})
+		}
+		res = append(res, group)
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// newEmoji returns the details of the emoji code.
+func newEmoji(code string) Emoji {
+	e := Emoji{Code: code, Aliases: emoji.Aliases(code)}
+	e.Name, _ = emoji.Name(code, "")
+	e.Group, e.Subgroup, _ = emoji.GroupOf(code)
+	e.Version, _ = emoji.Version(code)
+
+	return e
+}
+
+// writeError writes the error as JSON with the status code.
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, Error{Error: err.Error()})
+}
+
+// writeJSON writes the value as JSON with the status code.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
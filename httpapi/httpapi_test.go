@@ -0,0 +1,209 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	tt := []struct {
+		method   string
+		target   string
+		body     string
+		status   int
+		expected interface{}
+	}{
+		{
+			method:   http.MethodGet,
+			target:   "/parse?text=" + url.QueryEscape("deploy :rocket:"),
+			status:   http.StatusOK,
+			expected: &Text{Text: "deploy \U0001F680"},
+		},
+		{
+			method:   http.MethodPost,
+			target:   "/parse",
+			body:     "I like a :pizza:",
+			status:   http.StatusOK,
+			expected: &Text{Text: "I like a \U0001F355"},
+		},
+		{
+			method:   http.MethodPost,
+			target:   "/demojize",
+			body:     "I like a \U0001F355",
+			status:   http.StatusOK,
+			expected: &Text{Text: "I like a :pizza:"},
+		},
+		{
+			method: http.MethodGet,
+			target: "/emoji?alias=rocket",
+			status: http.StatusOK,
+			expected: &Emoji{
+				Code:     "\U0001F680",
+				Name:     "rocket",
+				Group:    "Travel & Places",
+				Subgroup: "transport-air",
+				Version:  "0.6",
+				Aliases:  []string{":rocket:"},
+			},
+		},
+		{
+			method: http.MethodGet,
+			target: "/emoji?code=" + url.QueryEscape("\U0001F355"),
+			status: http.StatusOK,
+			expected: &Emoji{
+				Code:     "\U0001F355",
+				Name:     "pizza",
+				Group:    "Food & Drink",
+				Subgroup: "food-prepared",
+				Version:  "0.6",
+				Aliases:  []string{":pizza:"},
+			},
+		},
+		{
+			method: http.MethodGet,
+			target: "/flag?code=tr",
+			status: http.StatusOK,
+			expected: &Emoji{
+				Code:     "\U0001F1F9\U0001F1F7",
+				Name:     "flag: Turkey",
+				Group:    "Flags",
+				Subgroup: "country-flag",
+				Version:  "2.0",
+				Aliases:  []string{":tr:", ":flag_for_turkey:"},
+			},
+		},
+		{
+			method:   http.MethodGet,
+			target:   "/emoji?alias=not_exist_emoji",
+			status:   http.StatusNotFound,
+			expected: &Error{Error: "emoji not found: \":not_exist_emoji:\""},
+		},
+		{
+			method:   http.MethodGet,
+			target:   "/emoji",
+			status:   http.StatusBadRequest,
+			expected: &Error{Error: "missing query parameter: alias or code"},
+		},
+		{
+			method:   http.MethodGet,
+			target:   "/search",
+			status:   http.StatusBadRequest,
+			expected: &Error{Error: "missing query parameter: q"},
+		},
+		{
+			method:   http.MethodGet,
+			target:   "/search?q=a&limit=1000",
+			status:   http.StatusBadRequest,
+			expected: &Error{Error: "not valid limit, expected 1 to 100: \"1000\""},
+		},
+		{
+			method:   http.MethodGet,
+			target:   "/search?q=a&limit=x",
+			status:   http.StatusBadRequest,
+			expected: &Error{Error: "not valid limit, expected 1 to 100: \"x\""},
+		},
+		{
+			method:   http.MethodDelete,
+			target:   "/parse",
+			status:   http.StatusMethodNotAllowed,
+			expected: &Error{Error: "method not allowed: DELETE"},
+		},
+		{
+			method:   http.MethodPost,
+			target:   "/groups",
+			status:   http.StatusMethodNotAllowed,
+			expected: &Error{Error: "method not allowed: POST"},
+		},
+	}
+
+	handler := NewHandler()
+	for i, tc := range tt {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body)))
+
+		if w.Code != tc.status {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, w.Code, tc.status)
+		}
+
+		got := reflect.New(reflect.TypeOf(tc.expected).Elem()).Interface()
+		if err := json.Unmarshal(w.Body.Bytes(), got); err != nil {
+			t.Fatalf("test case %v fail: %v", i+1, err)
+		}
+
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestHandlerSearch(t *testing.T) {
+	w := httptest.NewRecorder()
+	NewHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/search?q=rocket", nil))
+
+	var results []SearchResult
+	if err := json.Unmarshal(w.Body.Bytes(), &results); err != nil {
+		t.Fatalf("test case fail: %v", err)
+	}
+
+	if len(results) == 0 || results[0].Code != "\U0001F680" || results[0].Score == 0 {
+		t.Fatalf("test case fail: got: %v", results)
+	}
+}
+
+func TestHandlerSearchLimit(t *testing.T) {
+	tt := []struct {
+		target   string
+		expected int
+	}{
+		{target: "/search?q=a", expected: defaultSearchLimit},
+		{target: "/search?q=a&limit=3", expected: 3},
+		{target: "/search?q=a&limit=100", expected: 100},
+	}
+
+	handler := NewHandler()
+	for i, tc := range tt {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.target, nil))
+
+		var results []SearchResult
+		if err := json.Unmarshal(w.Body.Bytes(), &results); err != nil {
+			t.Fatalf("test case %v fail: %v", i+1, err)
+		}
+
+		if len(results) != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, len(results), tc.expected)
+		}
+	}
+}
+
+func TestHandlerGroups(t *testing.T) {
+	w := httptest.NewRecorder()
+	NewHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/groups", nil))
+
+	var groups []Group
+	if err := json.Unmarshal(w.Body.Bytes(), &groups); err != nil {
+		t.Fatalf("test case fail: %v", err)
+	}
+
+	if len(groups) == 0 || groups[0].Name != "Smileys & Emotion" || len(groups[0].Subgroups[0].Emojis) == 0 {
+		t.Fatalf("test case fail: got: %v", groups)
+	}
+}
+
+func TestHandlerMount(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/emoji/", http.StripPrefix("/emoji", NewHandler()))
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/emoji/parse?text=:tada:", nil))
+
+	expected := "{\"text\":\"\U0001F389\"}\n"
+	if got := w.Body.String(); got != expected {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, expected)
+	}
+}
@@ -0,0 +1,66 @@
+package emoji
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// TwemojiBaseURL is the base URL of Twemoji SVG images on the jsDelivr CDN,
+// which ImageRenderer uses if its URL scheme isn't set.
+const TwemojiBaseURL = "https://cdn.jsdelivr.net/gh/twitter/twemoji@14.0.2/assets/svg/"
+
+// URLScheme returns the image URL of the emoji code.
+type URLScheme func(code string) string
+
+// TwemojiURL returns the URL scheme of Twemoji style image sets, e.g. base + "1f44d-1f3fd.svg".
+// Variation selectors are removed unless the emoji is a ZWJ sequence as Twemoji does.
+func TwemojiURL(base, ext string) URLScheme {
+	return func(code string) string {
+		if !strings.ContainsRune(code, zeroWidthJoiner) {
+			code = strings.ReplaceAll(code, string(variationSelector), "")
+		}
+
+		return base + hexCodePoints(code, "%x", "-") + ext
+	}
+}
+
+// NotoURL returns the URL scheme of Noto style image sets, e.g. base + "emoji_u1f44d_1f3fd.png".
+// All variation selectors are removed as Noto does.
+func NotoURL(base, ext string) URLScheme {
+	return func(code string) string {
+		code = strings.ReplaceAll(code, string(variationSelector), "")
+
+		return base + "emoji_u" + hexCodePoints(code, "%04x", "_") + ext
+	}
+}
+
+// ImageRenderer replaces emojis with img elements for clients that can't render emoji characters.
+type ImageRenderer struct {
+	// URL returns the image URL of the emoji.
+	// It's TwemojiURL(TwemojiBaseURL, ".svg") if it's nil.
+	URL URLScheme
+	// Class is the class attribute of the img elements. It's "emoji" if it's empty.
+	Class string
+}
+
+// Render replaces emoji aliases (:pizza:) with unicode representation like Parse,
+// escapes the text for HTML, and replaces each emoji with an img element:
+//
+//	<img class="emoji" alt="🍕" src="https://example.com/1f355.svg">
+func (r ImageRenderer) Render(input string) string {
+	class := r.Class
+	if class == "" {
+		class = "emoji"
+	}
+
+	url := r.URL
+	if url == nil {
+		url = TwemojiURL(TwemojiBaseURL, ".svg")
+	}
+
+	return renderHTML(Parse(input), func(code, text string) string {
+		return fmt.Sprintf(`<img class="%s" alt="%s" src="%s">`,
+			html.EscapeString(class), html.EscapeString(text), html.EscapeString(url(code)))
+	})
+}
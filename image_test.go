@@ -0,0 +1,90 @@
+package emoji
+
+import (
+	"testing"
+)
+
+func TestTwemojiURL(t *testing.T) {
+	url := TwemojiURL("https://example.com/svg/", ".svg")
+
+	tt := []struct {
+		input    string
+		expected string
+	}{
+		{input: ThumbsUp.Tone(Medium), expected: "https://example.com/svg/1f44d-1f3fd.svg"},
+		{input: Rocket.String(), expected: "https://example.com/svg/1f680.svg"},
+		{input: Copyright.String(), expected: "https://example.com/svg/a9.svg"},
+		{input: Keycap1.String(), expected: "https://example.com/svg/31-20e3.svg"},
+		{input: EyeInSpeechBubble.String(), expected: "https://example.com/svg/1f441-fe0f-200d-1f5e8-fe0f.svg"},
+		{input: FlagForTurkey.String(), expected: "https://example.com/svg/1f1f9-1f1f7.svg"},
+	}
+
+	for i, tc := range tt {
+		got := url(tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestNotoURL(t *testing.T) {
+	url := NotoURL("https://example.com/png/", ".png")
+
+	tt := []struct {
+		input    string
+		expected string
+	}{
+		{input: ThumbsUp.Tone(Medium), expected: "https://example.com/png/emoji_u1f44d_1f3fd.png"},
+		{input: Rocket.String(), expected: "https://example.com/png/emoji_u1f680.png"},
+		{input: Copyright.String(), expected: "https://example.com/png/emoji_u00a9.png"},
+		{input: Keycap1.String(), expected: "https://example.com/png/emoji_u0031_20e3.png"},
+		{input: EyeInSpeechBubble.String(), expected: "https://example.com/png/emoji_u1f441_200d_1f5e8.png"},
+	}
+
+	for i, tc := range tt {
+		got := url(tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestImageRenderer(t *testing.T) {
+	tt := []struct {
+		renderer ImageRenderer
+		input    string
+		expected string
+	}{
+		{
+			renderer: ImageRenderer{URL: TwemojiURL("/e/", ".svg")},
+			input:    "<b>Ship it :rocket:</b> \U0001F44D\U0001F3FD",
+			expected: "&lt;b&gt;Ship it <img class=\"emoji\" alt=\"\U0001F680\" src=\"/e/1f680.svg\">&lt;/b&gt; " +
+				"<img class=\"emoji\" alt=\"\U0001F44D\U0001F3FD\" src=\"/e/1f44d-1f3fd.svg\">",
+		},
+		{
+			renderer: ImageRenderer{
+				URL:   func(code string) string { return "/emoji?code=" + code + "&size=16" },
+				Class: "icon",
+			},
+			input:    ":pizza:",
+			expected: "<img class=\"icon\" alt=\"\U0001F355\" src=\"/emoji?code=\U0001F355&amp;size=16\">",
+		},
+		{
+			renderer: ImageRenderer{},
+			input:    ":pizza:",
+			expected: "<img class=\"emoji\" alt=\"\U0001F355\" src=\"" + TwemojiBaseURL + "1f355.svg\">",
+		},
+		{
+			renderer: ImageRenderer{URL: NotoURL("/e/", ".png")},
+			input:    "no emoji",
+			expected: "no emoji",
+		},
+	}
+
+	for i, tc := range tt {
+		got := tc.renderer.Render(tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const cldrURL = "https://github.com/unicode-org/cldr/tree/main/common/annotations"
+
+// inheritedAnnotation marks annotations that are inherited from the parent locale.
+const inheritedAnnotation = "↑↑↑"
+
+type annotation struct {
+	Name     string
+	Keywords []string
+}
+
+type ldml struct {
+	Annotations []struct {
+		Code string `xml:"cp,attr"`
+		Type string `xml:"type,attr"`
+		Text string `xml:",chardata"`
+	} `xml:"annotations>annotation"`
+}
+
+// fetchAnnotations reads annotations of the locale from the local copy of CLDR common directory.
+// Annotations are keyed by emoji code without variation selectors as CLDR does.
+func fetchAnnotations(dir, locale string) (map[string]*annotation, error) {
+	r := make(map[string]*annotation)
+	if dir == "" {
+		return r, nil
+	}
+
+	for _, sub := range []string{"annotations", "annotationsDerived"} {
+		b, err := ioutil.ReadFile(filepath.Join(dir, sub, locale+".xml"))
+		if os.IsNotExist(err) && sub == "annotationsDerived" {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var doc ldml
+		if err = xml.Unmarshal(b, &doc); err != nil {
+			return nil, err
+		}
+
+		for _, a := range doc.Annotations {
+			text := strings.TrimSpace(a.Text)
+			if text == "" || text == inheritedAnnotation {
+				continue
+			}
+
+			an, ok := r[a.Code]
+			if !ok {
+				an = &annotation{}
+				r[a.Code] = an
+			}
+
+			if a.Type == "tts" {
+				an.Name = text
+				continue
+			}
+
+			for _, keyword := range strings.Split(text, "|") {
+				if keyword = strings.TrimSpace(keyword); keyword != "" {
+					an.Keywords = append(an.Keywords, keyword)
+				}
+			}
+		}
+	}
+
+	return r, nil
+}
+
+// fetchLocaleAnnotations reads annotations of the comma separated locales.
+// English is skipped because its names come from the emoji list.
+func fetchLocaleAnnotations(dir, locales string) (map[string]map[string]*annotation, error) {
+	r := make(map[string]map[string]*annotation)
+	for _, locale := range strings.Split(locales, ",") {
+		locale = strings.TrimSpace(locale)
+		if locale == "" || normalizeLocale(locale) == "en" {
+			continue
+		}
+
+		if dir == "" {
+			return nil, fmt.Errorf("cldr directory is required for locale: %q", locale)
+		}
+
+		annotations, err := fetchAnnotations(dir, locale)
+		if err != nil {
+			return nil, err
+		}
+		r[normalizeLocale(locale)] = annotations
+	}
+
+	return r, nil
+}
+
+// normalizeLocale returns the locale as the emoji package looks it up, e.g. "de_CH" => "de-ch".
+func normalizeLocale(locale string) string {
+	return strings.ReplaceAll(strings.ToLower(locale), "_", "-")
+}
+
+// findAnnotation returns the annotation of the emoji code.
+func findAnnotation(annotations map[string]*annotation, code string) (*annotation, bool) {
+	if an, ok := annotations[code]; ok {
+		return an, true
+	}
+
+	an, ok := annotations[strings.ReplaceAll(code, "\ufe0f", "")]
+
+	return an, ok
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"flag"
 	"fmt"
 	"go/format"
 	"io"
@@ -10,6 +11,7 @@ import (
 	"net/http"
 	"os"
 	"sort"
+	"strings"
 	"text/template"
 	"time"
 )
@@ -17,6 +19,13 @@ import (
 const (
 	constantsFile = "constants.go"
 	aliasesFile   = "map.go"
+	versionsFile  = "versions.go"
+	namesFile     = "names.go"
+	keywordsFile  = "keywords.go"
+	localesFile   = "locales.go"
+	tonesFile     = "tones.go"
+	groupsFile    = "groups.go"
+	variantsFile  = "variants.go"
 )
 
 // customEmojis is the list of emojis which unicode and gemoji databases don't have.
@@ -24,8 +33,16 @@ var customEmojis = map[string]string{
 	":robot_face:": "\U0001f916", // slack
 }
 
+var (
+	version = flag.String("version", "13.0", "Unicode Emoji version to generate constants for, e.g. 13.1, 14.0, 15.0")
+	cldrDir = flag.String("cldr", "", "Path to the local copy of CLDR common directory for emoji keywords")
+	locales = flag.String("locales", "", "Comma separated CLDR locales for localized emoji names, e.g. tr,de,ja")
+)
+
 func main() {
-	emojis, err := fetchEmojis()
+	flag.Parse()
+
+	emojis, err := fetchEmojis(*version)
 	if err != nil {
 		panic(err)
 	}
@@ -35,16 +52,61 @@ func main() {
 		panic(err)
 	}
 
+	annotations, err := fetchAnnotations(*cldrDir, "en")
+	if err != nil {
+		panic(err)
+	}
+
+	localeAnnotations, err := fetchLocaleAnnotations(*cldrDir, *locales)
+	if err != nil {
+		panic(err)
+	}
+
 	constants := generateConstants(emojis)
+	tones := generateTones(emojis)
+	groups := generateGroups(emojis)
+	variants := generateVariants(emojis)
 	aliases := generateAliases(emojis, gemojis)
+	versions := generateVersions(emojis)
+	names := generateNames(emojis)
+	keywords := generateKeywords(emojis, annotations)
+	localized := generateLocales(emojis, localeAnnotations)
 
-	if err = save(constantsFile, emojiListURL, constants); err != nil {
+	if err = save(constantsFile, emojiListURL(*version), constants); err != nil {
 		panic(err)
 	}
 
 	if err = save(aliasesFile, gemojiURL, aliases); err != nil {
 		panic(err)
 	}
+
+	if err = save(tonesFile, emojiListURL(*version), tones); err != nil {
+		panic(err)
+	}
+
+	if err = save(groupsFile, emojiListURL(*version), groups); err != nil {
+		panic(err)
+	}
+
+	if err = save(variantsFile, emojiListURL(*version), variants); err != nil {
+		panic(err)
+	}
+
+	if err = save(versionsFile, emojiListURL(*version), versions); err != nil {
+		panic(err)
+	}
+
+	if err = save(namesFile, emojiListURL(*version), names); err != nil {
+		panic(err)
+	}
+
+	if err = save(keywordsFile, cldrURL, keywords); err != nil {
+		panic(err)
+	}
+
+	if err = save(localesFile, cldrURL, localized); err != nil {
+		panic(err)
+	}
 }
 
 func generateConstants(emojis *groups) string {
@@ -79,8 +141,8 @@ func emojiConstant(emojis []emoji) string {
 		return fmt.Sprintf("%s EmojiWithTone = newEmojiWithTone(%+q) // %s\n",
 			basic.Constant, oneTonedCode, basic.Name)
 	case 26:
-		oneTonedCode := replaceTones(emojis[1].Code)
-		twoTonedCode := replaceTones(emojis[2].Code)
+		oneTonedCode := replaceTones(tonedEmoji(emojis, 1).Code)
+		twoTonedCode := replaceTones(tonedEmoji(emojis, 2).Code)
 
 		return fmt.Sprintf("%s EmojiWithTone = newEmojiWithTone(%+q, %+q) // %s\n",
 			basic.Constant, oneTonedCode, twoTonedCode, basic.Name)
@@ -89,6 +151,52 @@ func emojiConstant(emojis []emoji) string {
 	}
 }
 
+// tonedEmoji returns the first emoji that has given count of skin tones.
+func tonedEmoji(emojis []emoji, count int) emoji {
+	for _, e := range emojis {
+		if len(e.Tones) == count {
+			return e
+		}
+	}
+
+	panic(fmt.Errorf("not found emoji with %v tones: %v", count, emojis[0].Constant))
+}
+
+func generateTones(emojis *groups) string {
+	var r string
+	for _, grp := range emojis.Groups {
+		for _, subgrp := range grp.Subgroups {
+			for _, c := range subgrp.Constants {
+				if emojis := subgrp.Emojis[c]; len(emojis) > 1 {
+					r += fmt.Sprintf("%+q: %s,\n", emojis[0].Code, emojis[0].Constant)
+				}
+			}
+		}
+	}
+
+	return r
+}
+
+func generateGroups(emojis *groups) string {
+	var r string
+	for _, grp := range emojis.Groups {
+		r += fmt.Sprintf("{\nName: %q,\nSubgroups: []Subgroup{\n", grp.Name)
+		for _, subgrp := range grp.Subgroups {
+			r += fmt.Sprintf("{Name: %q, Emojis: []string{", subgrp.Name)
+			for i, c := range subgrp.Constants {
+				if i > 0 {
+					r += ", "
+				}
+				r += fmt.Sprintf("%+q", subgrp.Emojis[c][0].Code)
+			}
+			r += "}},\n"
+		}
+		r += "},\n},\n"
+	}
+
+	return r
+}
+
 func generateAliases(emojis *groups, gemojis map[string]string) string {
 	var aliases []string
 	var emojiMap = make(map[string]string)
@@ -134,6 +242,126 @@ func generateAliases(emojis *groups, gemojis map[string]string) string {
 
 	return r
 }
+
+func generateVersions(emojis *groups) string {
+	var r string
+	for _, grp := range emojis.Groups {
+		for _, subgrp := range grp.Subgroups {
+			for _, c := range subgrp.Constants {
+				for _, e := range subgrp.Emojis[c] {
+					r += fmt.Sprintf("%+q: %q,\n", e.Code, e.Version)
+				}
+			}
+		}
+	}
+
+	return r
+}
+
+func generateNames(emojis *groups) string {
+	var r string
+	for _, grp := range emojis.Groups {
+		for _, subgrp := range grp.Subgroups {
+			for _, c := range subgrp.Constants {
+				for _, e := range subgrp.Emojis[c] {
+					r += fmt.Sprintf("%+q: %q,\n", e.Code, e.Name)
+				}
+			}
+		}
+	}
+
+	return r
+}
+
+func generateKeywords(emojis *groups, annotations map[string]*annotation) string {
+	var r string
+	for _, grp := range emojis.Groups {
+		for _, subgrp := range grp.Subgroups {
+			for _, c := range subgrp.Constants {
+				emoji := subgrp.Emojis[c][0]
+				an, ok := findAnnotation(annotations, emoji.Code)
+				if !ok || len(an.Keywords) == 0 {
+					continue
+				}
+
+				var keywords []string
+				for _, keyword := range an.Keywords {
+					keywords = append(keywords, fmt.Sprintf("%q", keyword))
+				}
+				r += fmt.Sprintf("%+q: {%s},\n", emoji.Code, strings.Join(keywords, ", "))
+			}
+		}
+	}
+
+	return r
+}
+
+func generateLocales(emojis *groups, locales map[string]map[string]*annotation) string {
+	var localeNames []string
+	for locale := range locales {
+		localeNames = append(localeNames, locale)
+	}
+	sort.Strings(localeNames)
+
+	var names, keywords, aliases string
+	for _, locale := range localeNames {
+		annotations := locales[locale]
+		localeAliases := make(map[string]string)
+		names += fmt.Sprintf("%q: {\n", locale)
+		keywords += fmt.Sprintf("%q: {\n", locale)
+		for _, grp := range emojis.Groups {
+			for _, subgrp := range grp.Subgroups {
+				for _, c := range subgrp.Constants {
+					for i, e := range subgrp.Emojis[c] {
+						an, ok := findAnnotation(annotations, e.Code)
+						if !ok {
+							continue
+						}
+						if an.Name != "" {
+							names += fmt.Sprintf("%+q: %q,\n", e.Code, an.Name)
+						}
+						// aliases are generated only for the basic emoji
+						if alias := makeAlias(localSnakeCase(an.Name)); i == 0 && alias != "::" {
+							if _, ok := localeAliases[alias]; !ok {
+								localeAliases[alias] = e.Code
+							}
+						}
+						// keywords of toned emojis are same with the basic emoji
+						if i == 0 && len(an.Keywords) > 0 {
+							var quoted []string
+							for _, keyword := range an.Keywords {
+								quoted = append(quoted, fmt.Sprintf("%q", keyword))
+							}
+							keywords += fmt.Sprintf("%+q: {%s},\n", e.Code, strings.Join(quoted, ", "))
+						}
+					}
+				}
+			}
+		}
+		names += "},\n"
+		keywords += "},\n"
+
+		var sorted []string
+		for alias := range localeAliases {
+			sorted = append(sorted, alias)
+		}
+		sort.Strings(sorted)
+
+		aliases += fmt.Sprintf("%q: {\n", locale)
+		for _, alias := range sorted {
+			aliases += fmt.Sprintf("%q: %+q,\n", alias, localeAliases[alias])
+		}
+		aliases += "},\n"
+	}
+
+	return "// localeNames is the map of locales and their CLDR short names of emojis.\n" +
+		fmt.Sprintf("localeNames = map[string]map[string]string{\n%s}\n\n", names) +
+		"// localeKeywords is the map of locales and their CLDR annotation keywords of emojis.\n" +
+		fmt.Sprintf("localeKeywords = map[string]map[string][]string{\n%s}\n\n", keywords) +
+		"// localeAliases is the map of locales and their emoji aliases generated from CLDR short names.\n" +
+		fmt.Sprintf("localeAliases = map[string]map[string]string{\n%s}\n", aliases)
+}
+
 func save(filename, url, data string) error {
 	tmpl, err := template.ParseFiles(fmt.Sprintf("internal/generator/%v.tmpl", filename))
 	if err != nil {
@@ -141,13 +369,15 @@ func save(filename, url, data string) error {
 	}
 
 	d := struct {
-		Link string
-		Date string
-		Data string
+		Link    string
+		Date    string
+		Version string
+		Data    string
 	}{
-		Link: url,
-		Date: time.Now().Format(time.RFC3339),
-		Data: data,
+		Link:    url,
+		Date:    time.Now().Format(time.RFC3339),
+		Version: *version,
+		Data:    data,
 	}
 
 	var w bytes.Buffer
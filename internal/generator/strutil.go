@@ -137,3 +137,13 @@ func snakeCase(str string) string {
 func makeAlias(str string) string {
 	return ":" + str + ":"
 }
+
+// localSnakeCase converts localized name to snake_case.
+// Unlike clean, it keeps non-latin letters, e.g. "Daumen hoch" becomes "daumen_hoch".
+func localSnakeCase(str string) string {
+	words := strings.FieldsFunc(strings.ToLower(str), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.Is(unicode.Mn, r)
+	})
+
+	return strings.Join(words, "_")
+}
@@ -9,16 +9,21 @@ import (
 	emojipkg "github.com/enescakir/emoji"
 )
 
-const emojiListURL = "https://unicode.org/Public/emoji/13.0/emoji-test.txt"
+const emojiListURLFormat = "https://unicode.org/Public/emoji/%s/emoji-test.txt"
 
 var (
-	emojiRegex = regexp.MustCompile(`^(?m)(?P<code>[A-Z\d ]+[A-Z\d])\s+;\s+(fully-qualified|component)\s+#\s+.+\s+E\d+\.\d+ (?P<name>.+)$`)
+	emojiRegex = regexp.MustCompile(`^(?m)(?P<code>[A-Z\d ]+[A-Z\d])\s+;\s+(fully-qualified|component)\s+#\s+.+\s+E(?P<version>\d+\.\d+) (?P<name>.+)$`)
 	toneRegex  = regexp.MustCompile(`:\s.*tone,?`)
 )
 
-func fetchEmojis() (*groups, error) {
+// emojiListURL returns the emoji-test.txt URL of the given Unicode Emoji version.
+func emojiListURL(version string) string {
+	return fmt.Sprintf(emojiListURLFormat, version)
+}
+
+func fetchEmojis(version string) (*groups, error) {
 	var emojis groups
-	b, err := fetchData(emojiListURL)
+	b, err := fetchData(emojiListURL(version))
 	if err != nil {
 		return nil, err
 	}
@@ -93,25 +98,28 @@ type emoji struct {
 	Name     string
 	Constant string
 	Code     string
+	Version  string
 	Tones    []string
 }
 
 func (e *emoji) String() string {
-	return fmt.Sprintf("name:%v, constant:%v, code:%v, tones: %v\n", e.Name, e.Constant, e.Code, e.Tones)
+	return fmt.Sprintf("name:%v, constant:%v, code:%v, version:%v, tones: %v\n", e.Name, e.Constant, e.Code, e.Version, e.Tones)
 }
 
 func newEmoji(line string) *emoji {
 	matches := emojiRegex.FindStringSubmatch(line)
-	if len(matches) < 4 {
+	if len(matches) < 5 {
 		return nil
 	}
 	code := matches[1]
-	name := matches[3]
+	version := matches[3]
+	name := matches[4]
 
 	e := emoji{
 		Name:     name,
 		Constant: name,
 		Code:     code,
+		Version:  version,
 		Tones:    []string{},
 	}
 	e.extractAttr()
@@ -129,10 +137,14 @@ func (e *emoji) extractAttr() {
 	}
 	c := parts[0]
 	attrs := strings.Split(parts[1], ",")
+	toned := strings.Contains(parts[1], "tone")
 	for _, attr := range attrs {
 		switch {
 		case strings.Contains(attr, "tone"):
 			e.Tones = append(e.Tones, attr)
+		case toned && strings.TrimSpace(attr) == "person":
+			// "kiss: person, person, light skin tone, dark skin tone" is a toned variant of "kiss"
+			continue
 		case strings.Contains(attr, "beard"):
 			fallthrough
 		case strings.Contains(attr, "hair"):
@@ -161,7 +173,7 @@ func (e *emoji) generateUnicode() {
 		if err != nil {
 			panic(fmt.Errorf("unknown unicode: %v", v))
 		}
-		unicodes = append(unicodes, string(u))
+		unicodes = append(unicodes, string(rune(u)))
 	}
 
 	e.Code = strings.Join(unicodes, "")
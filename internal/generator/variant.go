@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var genderRegex = regexp.MustCompile(`\b(person|man|woman)\b`)
+
+// genders are the gender words in emoji names and their constants.
+var genders = map[string]string{
+	"":       "GenderNeutral",
+	"person": "GenderNeutral",
+	"man":    "GenderMan",
+	"woman":  "GenderWoman",
+}
+
+// hairs are the hair style attributes of emoji names and their constants.
+var hairs = map[string]string{
+	"":           "HairDefault",
+	"red hair":   "HairRed",
+	"curly hair": "HairCurly",
+	"white hair": "HairWhite",
+	"bald":       "HairBald",
+	"blond hair": "HairBlond",
+	"beard":      "HairBeard",
+}
+
+// familyAliases are the families whose neutral emojis are named differently.
+var familyAliases = map[string]string{
+	"older *": "old *",
+}
+
+// personVariant is the family, gender and hair style of a person emoji.
+type personVariant struct {
+	Code   string
+	Family string
+	Gender string
+	Hair   string
+}
+
+// newPersonVariant parses the gender and hair style of the emoji by its name, e.g. "man technologist"
+// or "woman: red hair". The family is the name without the gender and hair style, e.g. "* technologist".
+// Emojis with other attributes, like skin tones or family members, aren't person variants.
+func newPersonVariant(e emoji) (personVariant, bool) {
+	if len(e.Tones) > 0 {
+		return personVariant{}, false
+	}
+
+	base, attr := e.Name, ""
+	if parts := strings.SplitN(e.Name, ":", 2); len(parts) == 2 {
+		base, attr = parts[0], strings.TrimSpace(parts[1])
+	}
+
+	hair, ok := hairs[attr]
+	if !ok {
+		return personVariant{}, false
+	}
+
+	gender := ""
+	family := "* " + base
+	if loc := genderRegex.FindStringIndex(base); loc != nil {
+		gender = base[loc[0]:loc[1]]
+		family = base[:loc[0]] + "*" + base[loc[1]:]
+	}
+	if alias, ok := familyAliases[family]; ok {
+		family = alias
+	}
+
+	return personVariant{Code: e.Code, Family: family, Gender: genders[gender], Hair: hair}, true
+}
+
+func generateVariants(emojis *groups) string {
+	var variants []personVariant
+	families := make(map[string]int)
+	seen := make(map[string]bool)
+
+	for _, grp := range emojis.Groups {
+		for _, subgrp := range grp.Subgroups {
+			for _, c := range subgrp.Constants {
+				for _, e := range subgrp.Emojis[c] {
+					v, ok := newPersonVariant(e)
+					key := v.Family + v.Gender + v.Hair
+					if !ok || seen[key] {
+						continue
+					}
+					seen[key] = true
+
+					variants = append(variants, v)
+					families[v.Family]++
+				}
+			}
+		}
+	}
+
+	var r string
+	for _, v := range variants {
+		// emojis without other variants aren't person variants
+		if families[v.Family] < 2 {
+			continue
+		}
+
+		r += fmt.Sprintf("%+q: {family: %q, gender: %s, hair: %s},\n", v.Code, v.Family, v.Gender, v.Hair)
+	}
+
+	return r
+}
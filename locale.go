@@ -0,0 +1,79 @@
+package emoji
+
+import (
+	"sort"
+	"strings"
+)
+
+// defaultLocale is the locale of emoji names in the emoji list.
+const defaultLocale = "en"
+
+// Locales returns the locales that have localized emoji names.
+// English is always available, so it's not listed.
+func Locales() []string {
+	var locales []string
+	for locale := range localeNames {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	return locales
+}
+
+// Name returns the CLDR short name of the emoji in the given locale, e.g. "de".
+// Empty locale returns the English name. Regional locales like "de-AT" fall back to their language.
+func Name(code, locale string) (string, bool) {
+	names, _, ok := localeData(locale)
+	if !ok {
+		return "", false
+	}
+
+	name, ok := names[code]
+
+	return name, ok
+}
+
+// FindLocale returns the emoji code by the localized alias, e.g. ":daumen_hoch:" for "de".
+// English aliases are not included, use Find for them.
+func FindLocale(alias, locale string) (string, bool) {
+	locale, ok := findLocale(locale)
+	if !ok {
+		return "", false
+	}
+
+	code, ok := localeAliases[locale][alias]
+
+	return code, ok
+}
+
+// localeData returns emoji names and keywords of the locale.
+func localeData(locale string) (map[string]string, map[string][]string, bool) {
+	locale, ok := findLocale(locale)
+	switch {
+	case !ok:
+		return nil, nil, false
+	case locale == defaultLocale:
+		return emojiNames, emojiKeywords, true
+	default:
+		return localeNames[locale], localeKeywords[locale], true
+	}
+}
+
+// findLocale returns the available locale for the given locale.
+// Empty locale is English, and regional locales fall back to their language.
+func findLocale(locale string) (string, bool) {
+	locale = strings.ReplaceAll(strings.ToLower(locale), "_", "-")
+	language := strings.SplitN(locale, "-", 2)[0]
+
+	if language == "" || language == defaultLocale {
+		return defaultLocale, true
+	}
+
+	for _, l := range []string{locale, language} {
+		if _, ok := localeNames[l]; ok {
+			return l, true
+		}
+	}
+
+	return "", false
+}
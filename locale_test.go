@@ -0,0 +1,149 @@
+package emoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+// withLocale adds localized names and keywords, and returns a function that removes them.
+func withLocale(locale string, names map[string]string, keywords map[string][]string) func() {
+	localeNames[locale] = names
+	localeKeywords[locale] = keywords
+
+	return func() {
+		delete(localeNames, locale)
+		delete(localeKeywords, locale)
+		delete(localeAliases, locale)
+	}
+}
+
+// withLocaleAliases adds localized aliases, and returns a function that removes them.
+func withLocaleAliases(locale string, aliases map[string]string) func() {
+	reset := withLocale(locale, map[string]string{}, nil)
+	localeAliases[locale] = aliases
+
+	return reset
+}
+
+func TestName(t *testing.T) {
+	defer withLocale("de", map[string]string{
+		ThumbsUp.String():     "Daumen hoch",
+		ThumbsUp.Tone(Medium): "Daumen hoch: mittlere Hautfarbe",
+		Pizza.String():        "Pizza",
+	}, nil)()
+
+	tt := []struct {
+		code     string
+		locale   string
+		expected string
+		exist    bool
+	}{
+		{code: ThumbsUp.String(), locale: "", expected: "thumbs up", exist: true},
+		{code: ThumbsUp.String(), locale: "en", expected: "thumbs up", exist: true},
+		{code: ThumbsUp.String(), locale: "en-US", expected: "thumbs up", exist: true},
+		{code: ThumbsUp.Tone(Dark), locale: "en", expected: "thumbs up: dark skin tone", exist: true},
+		{code: ThumbsUp.String(), locale: "de", expected: "Daumen hoch", exist: true},
+		{code: ThumbsUp.String(), locale: "de_AT", expected: "Daumen hoch", exist: true},
+		{code: ThumbsUp.Tone(Medium), locale: "DE", expected: "Daumen hoch: mittlere Hautfarbe", exist: true},
+		{code: Rocket.String(), locale: "de", expected: "", exist: false},
+		{code: ThumbsUp.String(), locale: "xx", expected: "", exist: false},
+		{code: "not emoji", locale: "en", expected: "", exist: false},
+	}
+
+	for i, tc := range tt {
+		got, exist := Name(tc.code, tc.locale)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+
+		if exist != tc.exist {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, exist, tc.exist)
+		}
+	}
+}
+
+func TestFindLocale(t *testing.T) {
+	defer withLocaleAliases("de", map[string]string{
+		":daumen_hoch:": ThumbsUp.String(),
+		":pizza:":       Pizza.String(),
+	})()
+
+	tt := []struct {
+		alias    string
+		locale   string
+		expected string
+		exist    bool
+	}{
+		{alias: ":daumen_hoch:", locale: "de", expected: ThumbsUp.String(), exist: true},
+		{alias: ":daumen_hoch:", locale: "de-CH", expected: ThumbsUp.String(), exist: true},
+		{alias: ":pizza:", locale: "de", expected: Pizza.String(), exist: true},
+		{alias: ":daumen_hoch:", locale: "en", expected: "", exist: false},
+		{alias: ":daumen_hoch:", locale: "tr", expected: "", exist: false},
+		{alias: ":rocket:", locale: "de", expected: "", exist: false},
+	}
+
+	for i, tc := range tt {
+		got, exist := FindLocale(tc.alias, tc.locale)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+
+		if exist != tc.exist {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, exist, tc.exist)
+		}
+	}
+}
+
+func TestLocales(t *testing.T) {
+	defer withLocale("tr", map[string]string{}, nil)()
+	defer withLocale("de", map[string]string{}, nil)()
+
+	got := Locales()
+	expected := []string{"de", "tr"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, expected)
+	}
+}
+
+func TestSearchLocale(t *testing.T) {
+	defer withLocale("de", map[string]string{
+		ThumbsUp.String(): "Daumen hoch",
+		Pizza.String():    "Pizza",
+	}, map[string][]string{
+		ThumbsUp.String(): {"Daumen", "Daumen hoch", "gut", "Hand", "Like"},
+		Pizza.String():    {"Käse", "Pizza", "Pizzastück"},
+	})()
+
+	tt := []struct {
+		query    string
+		locale   string
+		expected string
+		name     string
+	}{
+		{query: "daumen", locale: "de", expected: ThumbsUp.String(), name: "Daumen hoch"},
+		{query: "käse", locale: "de", expected: Pizza.String(), name: "Pizza"},
+		{query: "pizzastü", locale: "de-DE", expected: Pizza.String(), name: "Pizza"},
+		{query: "rocket", locale: "de", expected: Rocket.String(), name: "rocket"},
+		{query: "pizza", locale: "en", expected: Pizza.String(), name: "pizza"},
+	}
+
+	for i, tc := range tt {
+		results := SearchLocale(tc.query, tc.locale)
+		if len(results) == 0 {
+			t.Fatalf("test case %v fail: no result", i+1)
+		}
+
+		got := results[0]
+		if got.Code != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got.Code, tc.expected)
+		}
+
+		if got.Name != tc.name {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got.Name, tc.name)
+		}
+	}
+
+	if got := SearchLocale("pizza", "xx"); len(got) != 0 {
+		t.Fatalf("test case fail: got: %v, expected no result", got)
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/enescakir/emoji/markdown
+
+go 1.13
+
+require (
+	github.com/enescakir/emoji v0.0.0-20261019162532-5813f29cf44a
+	github.com/yuin/goldmark v1.4.12
+)
+
+// The emoji module is replaced with the local copy to develop both modules together.
+// Users of this module get the required version above, because replace directives of dependencies are ignored.
+replace github.com/enescakir/emoji => ../
@@ -0,0 +1,141 @@
+// Package markdown is a goldmark extension that replaces emoji aliases (:pizza:) with unicode representation.
+//
+// Aliases are parsed as inline nodes, so they are left untouched in code spans and code blocks:
+//
+//	md := goldmark.New(goldmark.WithExtensions(markdown.Emoji))
+//	md.Convert([]byte("Ship it :rocket: `:rocket:`"), &buf) // <p>Ship it 🚀 <code>:rocket:</code></p>
+package markdown
+
+import (
+	"html"
+	"unicode"
+
+	"github.com/enescakir/emoji"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// KindEmoji is the ast.NodeKind of emoji nodes.
+var KindEmoji = ast.NewNodeKind("Emoji")
+
+// Node is an inline node of an emoji alias.
+type Node struct {
+	ast.BaseInline
+
+	// Alias is the emoji alias in the source, e.g. ":pizza:".
+	Alias string
+	// Code is the unicode representation of the emoji.
+	Code string
+}
+
+// Kind implements ast.Node.Kind.
+func (n *Node) Kind() ast.NodeKind {
+	return KindEmoji
+}
+
+// Dump implements ast.Node.Dump.
+func (n *Node) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{
+		"Alias": n.Alias,
+		"Code":  n.Code,
+	}, nil)
+}
+
+// Extension is the goldmark extension of emoji aliases.
+// The zero value parses aliases like emoji.Parse and renders emojis as text.
+type Extension struct {
+	// Parser finds emojis of the aliases, so its options, e.g. MaxVersion, apply to Markdown too.
+	Parser emoji.Parser
+
+	// RenderHTML returns the HTML of the emoji code. The emoji is escaped as text if it's nil.
+	// emoji.ParseHTML and emoji.ImageRenderer.Render can be used for accessible elements or images.
+	RenderHTML func(code string) string
+}
+
+// Emoji is the extension with default options.
+var Emoji = &Extension{}
+
+// Extend implements goldmark.Extender.
+func (e *Extension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(&inlineParser{parser: e.Parser}, 999),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(&nodeRenderer{render: e.RenderHTML}, 500),
+	))
+}
+
+// inlineParser parses emoji aliases into emoji nodes.
+type inlineParser struct {
+	parser emoji.Parser
+}
+
+// Trigger implements parser.InlineParser.Trigger.
+func (p *inlineParser) Trigger() []byte {
+	return []byte{':'}
+}
+
+// Parse implements parser.InlineParser.Parse.
+// The alias ends with the next colon in the line. Aliases can't contain spaces.
+func (p *inlineParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, segment := block.PeekLine()
+
+	end := -1
+	for i, r := range string(line) {
+		if i == 0 {
+			continue
+		}
+		if unicode.IsSpace(r) {
+			return nil
+		}
+		if r == ':' {
+			end = i + 1
+			break
+		}
+	}
+	if end < 0 {
+		return nil
+	}
+
+	alias := string(line[:end])
+	code, ok := p.parser.Find(alias)
+	if !ok {
+		return nil
+	}
+
+	block.Advance(end)
+	node := &Node{Alias: alias, Code: code}
+	node.AppendChild(node, ast.NewTextSegment(segment.WithStop(segment.Start+end)))
+
+	return node
+}
+
+// nodeRenderer renders emoji nodes to HTML.
+type nodeRenderer struct {
+	render func(code string) string
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.RegisterFuncs.
+func (r *nodeRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindEmoji, r.renderEmoji)
+}
+
+// renderEmoji writes the emoji and skips the alias text of the node.
+func (r *nodeRenderer) renderEmoji(w util.BufWriter, source []byte, n ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	code := n.(*Node).Code
+	if r.render != nil {
+		_, _ = w.WriteString(r.render(code))
+	} else {
+		_, _ = w.WriteString(html.EscapeString(code))
+	}
+
+	return ast.WalkSkipChildren, nil
+}
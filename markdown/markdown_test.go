@@ -0,0 +1,65 @@
+package markdown
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/enescakir/emoji"
+	"github.com/yuin/goldmark"
+)
+
+func TestExtension(t *testing.T) {
+	tt := []struct {
+		extension *Extension
+		input     string
+		expected  string
+	}{
+		{
+			extension: Emoji,
+			input:     "Ship it :rocket: :tada:",
+			expected:  "<p>Ship it \U0001F680 \U0001F389</p>\n",
+		},
+		{
+			extension: Emoji,
+			input:     "Use `:tada:` for releases :tada:",
+			expected:  "<p>Use <code>:tada:</code> for releases \U0001F389</p>\n",
+		},
+		{
+			extension: Emoji,
+			input:     "```\n:tada:\n```\n\n    :rocket:\n",
+			expected:  "<pre><code>:tada:\n</code></pre>\n<pre><code>:rocket:\n</code></pre>\n",
+		},
+		{
+			extension: Emoji,
+			input:     "**:pizza:**:not_exist_emoji::flag-tr: at 12:30:45 :",
+			expected:  "<p><strong>\U0001F355</strong>:not_exist_emoji:\U0001F1F9\U0001F1F7 at 12:30:45 :</p>\n",
+		},
+		{
+			extension: Emoji,
+			input:     "[:link: docs](https://example.com/:rocket:)",
+			expected:  "<p><a href=\"https://example.com/:rocket:\">\U0001F517 docs</a></p>\n",
+		},
+		{
+			extension: &Extension{Parser: emoji.Parser{MaxVersion: "11.0"}},
+			input:     ":yawning_face: :pizza:",
+			expected:  "<p>:yawning_face: \U0001F355</p>\n",
+		},
+		{
+			extension: &Extension{RenderHTML: emoji.ImageRenderer{URL: emoji.TwemojiURL("/e/", ".svg")}.Render},
+			input:     ":pizza:",
+			expected:  "<p><img class=\"emoji\" alt=\"\U0001F355\" src=\"/e/1f355.svg\"></p>\n",
+		},
+	}
+
+	for i, tc := range tt {
+		var buf bytes.Buffer
+		md := goldmark.New(goldmark.WithExtensions(tc.extension))
+		if err := md.Convert([]byte(tc.input), &buf); err != nil {
+			t.Fatalf("test case %v fail: %v", i+1, err)
+		}
+
+		if got := buf.String(); got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package emoji
+
+import (
+	"fmt"
+)
+
+// AliasEmoji is an emoji that is marshaled with its shortest alias, e.g. :+1:
+// Emojis without aliases are marshaled with their unicode representations.
+// It's useful for JSON and YAML fields that are edited by hand, and for databases that can't store 4-byte UTF-8 characters,
+// e.g. AliasEmoji(emoji.ThumbsUp.String()).
+type AliasEmoji Emoji
+
+// String returns string representation of the emoji.
+func (e AliasEmoji) String() string {
+	return string(e)
+}
+
+// MarshalText implements encoding.TextMarshaler for the simple emoji.
+func (e Emoji) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for the simple emoji.
+// It accepts the unicode representation or an alias of the emoji.
+func (e *Emoji) UnmarshalText(text []byte) error {
+	code, err := unmarshalCode(string(text))
+	if err != nil {
+		return err
+	}
+
+	*e = Emoji(code)
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for the alias emoji.
+func (e AliasEmoji) MarshalText() ([]byte, error) {
+	return []byte(aliasCode(e.String())), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for the alias emoji.
+// It accepts the unicode representation or an alias of the emoji.
+func (e *AliasEmoji) UnmarshalText(text []byte) error {
+	return (*Emoji)(e).UnmarshalText(text)
+}
+
+// MarshalText implements encoding.TextMarshaler for the emoji with skin tone options.
+// The emoji is marshaled with the default skin tone.
+func (e EmojiWithTone) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for the emoji with skin tone options.
+// It accepts the unicode representation or an alias of the emoji.
+// Skin tones of the text are ignored, because EmojiWithTone doesn't keep a skin tone.
+func (e *EmojiWithTone) UnmarshalText(text []byte) error {
+	code, err := unmarshalCode(string(text))
+	if err != nil {
+		return err
+	}
+
+	if code == "" {
+		*e = EmojiWithTone{}
+		return nil
+	}
+
+	emoji, _, ok := findEmojiWithTone(code)
+	if !ok {
+		return fmt.Errorf("emoji doesn't have skin tone options: %q", text)
+	}
+
+	*e = emoji
+
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler for the skin tone.
+// The skin tone is marshaled with its name, e.g. "medium-dark".
+func (t Tone) MarshalText() ([]byte, error) {
+	name := t.Name()
+	if name == "" {
+		return nil, fmt.Errorf("not valid skin tone: %+q", t)
+	}
+
+	return []byte(name), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for the skin tone.
+// It accepts the names of the skin tones like ParseTone, e.g. "medium-dark".
+func (t *Tone) UnmarshalText(text []byte) error {
+	tone, err := ParseTone(string(text))
+	if err != nil {
+		return err
+	}
+
+	*t = tone
+
+	return nil
+}
+
+// aliasCode returns the shortest alias of the emoji code, or the code itself if it doesn't have an alias.
+func aliasCode(code string) string {
+	if alias, ok := FindAlias(code); ok {
+		return alias
+	}
+
+	return code
+}
+
+// unmarshalCode returns the emoji code from its alias or unicode representation.
+// Unicode representations are normalized, so unqualified emojis like ❤ are accepted as ❤️.
+// Emojis that are newer than the emoji list of the package, e.g. 🫠, are accepted as they are
+// if they consist of pictographs and emoji sequence characters.
+func unmarshalCode(text string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	if code, ok := Find(text); ok {
+		return code, nil
+	}
+
+	if code, size := emojiTrie().match(text); code != "" && size == len(text) {
+		return code, nil
+	}
+
+	// text style symbols like © are emojis alone, but they aren't matched in texts without variation selectors
+	if code := text + string(variationSelector); isEmoji(code) {
+		return code, nil
+	}
+
+	if isEmojiSequence(text) {
+		return text, nil
+	}
+
+	return "", fmt.Errorf("not valid emoji: %q", text)
+}
+
+// isEmoji checks whether the code is a known emoji.
+func isEmoji(code string) bool {
+	if _, ok := emojiNames[code]; ok {
+		return true
+	}
+
+	_, ok := FindAlias(code)
+
+	return ok
+}
+
+// isEmojiSequence checks whether the text starts with a pictograph and consists of pictographs,
+// skin tones, variation selectors, joiners, keycaps and tags, so it looks like an emoji that isn't known yet.
+func isEmojiSequence(text string) bool {
+	if !isPictograph(text) {
+		return false
+	}
+
+	for _, r := range text {
+		switch {
+		case isPictograph(string(r)):
+		case r == variationSelector, r == zeroWidthJoiner, r == '\u20e3':
+		case r >= 0xe0020 && r <= 0xe007f:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,138 @@
+package emoji
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type reaction struct {
+	Emoji Emoji         `json:"emoji"`
+	Hand  EmojiWithTone `json:"hand"`
+	Tone  Tone          `json:"tone"`
+	Alias AliasEmoji    `json:"alias"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	tt := []struct {
+		input    reaction
+		expected string
+	}{
+		{
+			input:    reaction{Emoji: Rocket, Hand: ThumbsUp, Tone: MediumDark, Alias: AliasEmoji(Rocket)},
+			expected: "{\"emoji\":\"\U0001F680\",\"hand\":\"\U0001F44D\",\"tone\":\"medium-dark\",\"alias\":\":rocket:\"}",
+		},
+		{
+			input:    reaction{Emoji: FlagForTurkey, Hand: WavingHand, Tone: Light, Alias: AliasEmoji(ThumbsUp.String())},
+			expected: "{\"emoji\":\"\U0001F1F9\U0001F1F7\",\"hand\":\"\U0001F44B\",\"tone\":\"light\",\"alias\":\":+1:\"}",
+		},
+		{
+			input:    reaction{Alias: AliasEmoji("custom")},
+			expected: `{"emoji":"","hand":"","tone":"default","alias":"custom"}`,
+		},
+		{
+			input:    reaction{},
+			expected: `{"emoji":"","hand":"","tone":"default","alias":""}`,
+		},
+	}
+
+	for i, tc := range tt {
+		got, err := json.Marshal(tc.input)
+		if err != nil {
+			t.Fatalf("test case %v fail: %v", i+1, err)
+		}
+
+		if string(got) != tc.expected {
+			t.Fatalf("test case %v fail: got: %s, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected reaction
+	}{
+		{
+			input:    "{\"emoji\":\"\U0001F680\",\"hand\":\"\U0001F44D\",\"tone\":\"medium-dark\"}",
+			expected: reaction{Emoji: Rocket, Hand: ThumbsUp, Tone: MediumDark},
+		},
+		{
+			input:    `{"emoji":":rocket:","hand":":+1:","tone":"light","alias":":rocket:"}`,
+			expected: reaction{Emoji: Rocket, Hand: ThumbsUp, Tone: Light, Alias: AliasEmoji(Rocket)},
+		},
+		{
+			input:    "{\"alias\":\"\U0001F44D\",\"tone\":\"default\"}",
+			expected: reaction{Alias: AliasEmoji(ThumbsUp.String())},
+		},
+		{
+			input:    "{\"emoji\":\":flag-tr:\",\"hand\":\"\U0001F44D\U0001F3FD\",\"tone\":\"default\"}",
+			expected: reaction{Emoji: FlagForTurkey, Hand: ThumbsUp, Tone: Default},
+		},
+		{
+			input:    `{"emoji":"","hand":"","tone":"default"}`,
+			expected: reaction{},
+		},
+	}
+
+	for i, tc := range tt {
+		var got reaction
+		if err := json.Unmarshal([]byte(tc.input), &got); err != nil {
+			t.Fatalf("test case %v fail: %v", i+1, err)
+		}
+
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestEmojiUnmarshalText(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected Emoji
+	}{
+		{input: "\u2764", expected: RedHeart},
+		{input: "\u2764\ufe0f", expected: RedHeart},
+		{input: "\u263a", expected: SmilingFace},
+		{input: "\u270c", expected: Emoji(VictoryHand.String())},
+		{input: "©", expected: Copyright},
+		{input: "\U0001F441\u200d\U0001F5E8", expected: EyeInSpeechBubble},
+		{input: "\U0001FAE0", expected: Emoji("\U0001FAE0")},
+		{input: "\U0001FAF1\U0001F3FB\u200d\U0001FAF2\U0001F3FF", expected: Emoji("\U0001FAF1\U0001F3FB\u200d\U0001FAF2\U0001F3FF")},
+	}
+
+	for i, tc := range tt {
+		var got Emoji
+		if err := got.UnmarshalText([]byte(tc.input)); err != nil {
+			t.Fatalf("test case %v fail: %v", i+1, err)
+		}
+
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %+q, expected: %+q", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestUnmarshalJSONError(t *testing.T) {
+	tt := []string{
+		`{"emoji":"not emoji"}`,
+		`{"emoji":":not_exist_emoji:"}`,
+		`{"hand":":rocket:"}`,
+		`{"tone":"purple"}`,
+		`{"alias":":not_exist_emoji:"}`,
+		"{\"emoji\":\"\u2764 love\"}",
+	}
+
+	for i, tc := range tt {
+		var got reaction
+		if err := json.Unmarshal([]byte(tc), &got); err == nil {
+			t.Fatalf("test case %v fail: expected error, got: %v", i+1, got)
+		}
+	}
+}
+
+func TestToneMarshalTextError(t *testing.T) {
+	if _, err := Tone("x").MarshalText(); err == nil {
+		t.Fatalf("test case fail: expected error")
+	}
+}
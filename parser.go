@@ -3,20 +3,110 @@ package emoji
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
 	"unicode"
 )
 
 var (
 	flagRegex = regexp.MustCompile(`^:flag-([a-zA-Z]{2}):$`)
+
+	// literalRegex matches the texts that context aware parsers leave untouched:
+	// code blocks and spans, URLs and times like 12:30:45.
+	// Code spans don't span lines, so a stray backtick doesn't leave the following lines unexpanded.
+	literalRegex = regexp.MustCompile("```[\\s\\S]*?```|`[^`\\n]*`|[a-zA-Z][a-zA-Z0-9+.-]*://\\S+|\\b\\d{1,2}(?::\\d{2}){1,2}\\b")
+
+	// codeAliases is the map of emoji codes and their aliases.
+	// It's built from the emojis map on first use.
+	codeAliases     map[string][]string
+	codeAliasesOnce sync.Once
 )
 
+// Parser replaces emoji aliases (:pizza:) with unicode representation by its options.
+// The zero value of Parser works like Parse.
+type Parser struct {
+	// MaxVersion is the latest Unicode Emoji version of the emojis that parser produces, e.g. "12.0".
+	// Aliases of newer emojis are left unexpanded unless Fallback is set.
+	// Empty MaxVersion allows all emojis.
+	MaxVersion string
+
+	// Fallback returns the replacement for the alias of an emoji that is newer than MaxVersion.
+	Fallback func(alias string) string
+
+	// Locales are the locales whose localized aliases (:daumen_hoch:) are parsed besides the English ones.
+	Locales []string
+
+	// Unknown returns the replacement for the alias that isn't found, e.g. the emoji of its nearest alias by Suggest.
+	// The alias is left unexpanded if Unknown is nil or it reports false.
+	Unknown func(alias string) (string, bool)
+
+	// ContextAware leaves aliases in code spans (`:tada:`), code blocks, URLs and times (12:30:45) unexpanded.
+	ContextAware bool
+
+	// ToneSyntaxes are the syntaxes of skin tone shortcodes that are applied to the aliases of emojis with skin tones,
+	// e.g. ToneSyntaxSlack. Zero ToneSyntaxes parses all syntaxes, and ToneSyntaxNone parses none of them.
+	ToneSyntaxes ToneSyntax
+}
+
+// ToneSyntax is a set of syntaxes of skin tone shortcodes.
+type ToneSyntax int
+
+// Syntaxes of skin tone shortcodes
+const (
+	// ToneSyntaxSlack is the :skin-tone-N: shortcode after the alias, e.g. :thumbsup::skin-tone-4:
+	// N is from 2 (light) to 6 (dark). It's used by Slack and GitHub, and Demojize produces it.
+	ToneSyntaxSlack ToneSyntax = 1 << iota
+	// ToneSyntaxSuffix is the _toneN suffix of the alias, e.g. :+1_tone3:
+	// N is from 1 (light) to 5 (dark).
+	ToneSyntaxSuffix
+	// ToneSyntaxNone disables skin tone shortcodes.
+	ToneSyntaxNone
+
+	// ToneSyntaxAll is the set of all syntaxes of skin tone shortcodes.
+	ToneSyntaxAll = ToneSyntaxSlack | ToneSyntaxSuffix
+)
+
+// defaultParser is the parser that is used by Parse.
+var defaultParser Parser
+
 // Parse replaces emoji aliases (:pizza:) with unicode representation.
 func Parse(input string) string {
+	return defaultParser.Parse(input)
+}
+
+// Parse replaces emoji aliases (:pizza:) with unicode representation.
+func (p Parser) Parse(input string) string {
+	if !p.ContextAware {
+		return p.parse(input)
+	}
+
+	var output strings.Builder
+
+	start := 0
+	for _, loc := range literalRegex.FindAllStringIndex(input, -1) {
+		output.WriteString(p.parse(input[start:loc[0]]))
+		output.WriteString(input[loc[0]:loc[1]])
+		start = loc[1]
+	}
+	output.WriteString(p.parse(input[start:]))
+
+	return output.String()
+}
+
+// parse replaces emoji aliases in the input without looking at its context.
+func (p Parser) parse(input string) string {
 	var matched strings.Builder
 	var output strings.Builder
 
-	for _, r := range input {
+	// skip is the end of the skin tone shortcodes that are applied to the last emoji
+	skip := 0
+
+	for i, r := range input {
+		if i < skip {
+			continue
+		}
+
 		// when it's not `:`, it might be inner or outer of the emoji alias
 		if r != ':' {
 			// if matched is empty, it's the outer of the emoji alias
@@ -47,10 +137,11 @@ func Parse(input string) string {
 		match := matched.String()
 		alias := match + ":"
 
-		// check for emoji alias
-		if code, ok := Find(alias); ok {
+		// check for emoji alias and its skin tone shortcodes
+		if code, n, ok := p.findWithTones(alias, input[i+1:]); ok {
 			output.WriteString(code)
 			matched.Reset()
+			skip = i + 1 + n
 			continue
 		}
 
@@ -71,6 +162,172 @@ func Parse(input string) string {
 	return output.String()
 }
 
+// Find returns the emoji code by alias if the parser options allow the emoji.
+// Aliases of newer emojis than MaxVersion return the Fallback replacement if it's set,
+// and unknown aliases return the Unknown replacement if it's set.
+func (p Parser) Find(alias string) (string, bool) {
+	code, ok := p.lookup(alias)
+	if !ok {
+		if p.Unknown != nil {
+			return p.Unknown(alias)
+		}
+
+		return "", false
+	}
+
+	return p.allow(alias, code)
+}
+
+// lookup returns the emoji code by the English alias or the alias of the parser locales.
+func (p Parser) lookup(alias string) (string, bool) {
+	code, ok := Find(alias)
+	for i := 0; !ok && i < len(p.Locales); i++ {
+		code, ok = FindLocale(alias, p.Locales[i])
+	}
+
+	return code, ok
+}
+
+// allow returns the emoji code if it isn't newer than MaxVersion, or the Fallback replacement of the alias.
+func (p Parser) allow(alias, code string) (string, bool) {
+	if p.MaxVersion != "" && !Supported(code, p.MaxVersion) {
+		if p.Fallback != nil {
+			return p.Fallback(alias), true
+		}
+
+		return "", false
+	}
+
+	return code, true
+}
+
+// findWithTones returns the emoji code of the alias with the skin tones of its shortcodes,
+// and the length of the skin tone shortcodes that follow the alias in the rest of the input.
+// Unknown is called only once with the alias if neither the alias nor its base alias without _toneN suffixes is found.
+func (p Parser) findWithTones(alias, rest string) (string, int, bool) {
+	syntaxes := p.toneSyntaxes()
+
+	if syntaxes&ToneSyntaxSuffix != 0 {
+		if base, tones := splitToneSuffixes(alias); len(tones) > 0 {
+			if code, ok := p.lookup(base); ok {
+				if toned, ok := applyTones(code, tones); ok {
+					code, ok = p.allow(alias, toned)
+
+					return code, 0, ok
+				}
+			}
+		}
+	}
+
+	code, ok := p.lookup(alias)
+	if !ok {
+		if p.Unknown != nil {
+			code, ok = p.Unknown(alias)
+
+			return code, 0, ok
+		}
+
+		return "", 0, false
+	}
+
+	var tones []Tone
+	n := 0
+	if emoji, current, toneable := findEmojiWithTone(code); toneable && len(current) == 0 && syntaxes&ToneSyntaxSlack != 0 {
+		for len(tones) < emoji.ToneSlots() {
+			t, ok := prefixToneAlias(rest[n:])
+			if !ok {
+				break
+			}
+
+			tones = append(tones, t)
+			n += len(toneAlias(t))
+		}
+	}
+
+	if toned, ok := applyTones(code, tones); ok && len(tones) > 0 {
+		code = toned
+	} else {
+		n = 0
+	}
+
+	if code, ok = p.allow(alias, code); !ok {
+		return "", 0, false
+	}
+
+	return code, n, true
+}
+
+// applyTones returns the emoji code with the skin tones if the emoji takes them.
+func applyTones(code string, tones []Tone) (string, bool) {
+	emoji, current, ok := findEmojiWithTone(code)
+	if !ok || len(current) > 0 {
+		return "", false
+	}
+
+	toned, err := emoji.CheckedTone(tones...)
+	if err != nil {
+		return "", false
+	}
+
+	return toned, true
+}
+
+// toneSyntaxes returns the syntaxes of skin tone shortcodes that the parser applies.
+func (p Parser) toneSyntaxes() ToneSyntax {
+	switch {
+	case p.ToneSyntaxes == 0:
+		return ToneSyntaxAll
+	case p.ToneSyntaxes&ToneSyntaxNone != 0:
+		return 0
+	default:
+		return p.ToneSyntaxes
+	}
+}
+
+// prefixToneAlias returns the skin tone of the :skin-tone-N: alias at the beginning of the text.
+func prefixToneAlias(text string) (Tone, bool) {
+	for _, t := range skinTones {
+		if strings.HasPrefix(text, toneAlias(t)) {
+			return t, true
+		}
+	}
+
+	return "", false
+}
+
+// toneSuffix returns the _toneN suffix of the skin tone.
+func toneSuffix(t Tone) string {
+	for i, tone := range skinTones {
+		if tone == t {
+			return fmt.Sprintf("_tone%d", i+1)
+		}
+	}
+
+	return ""
+}
+
+// splitToneSuffixes splits the alias and its _toneN suffixes, e.g. ":+1_tone3:" => ":+1:", [Medium].
+func splitToneSuffixes(alias string) (string, []Tone) {
+	name := strings.TrimSuffix(alias, ":")
+
+	var tones []Tone
+	for {
+		matched := false
+		for _, t := range skinTones {
+			if suffix := toneSuffix(t); strings.HasSuffix(name, suffix) && len(name) > len(suffix)+1 {
+				name = strings.TrimSuffix(name, suffix)
+				tones = append([]Tone{t}, tones...)
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return name + ":", tones
+		}
+	}
+}
+
 // Map returns the emojis map.
 // Key is the alias of the emoji.
 // Value is the code of the emoji.
@@ -90,6 +347,8 @@ func AppendAlias(alias, code string) error {
 		}
 	}
 
+	// indexes are built from the emojis map on first use, so the alias is indexed before it's added to the map
+	indexAlias(alias, code)
 	emojiMap[alias] = code
 
 	return nil
@@ -115,6 +374,59 @@ func Find(alias string) (string, bool) {
 	return "", false
 }
 
+// FindAlias returns the shortest alias of the emoji code, e.g. ":+1:" for 👍.
+func FindAlias(code string) (string, bool) {
+	aliases := aliasIndex()[code]
+	if len(aliases) == 0 {
+		return "", false
+	}
+
+	return aliases[0], true
+}
+
+// Aliases returns all aliases of the emoji code, shortest first.
+func Aliases(code string) []string {
+	aliases := aliasIndex()[code]
+
+	return append([]string(nil), aliases...)
+}
+
+// Demojize replaces emojis with their shortest aliases (🍕 => :pizza:). It's the reverse of Parse.
+// Skin tones are replaced with :skin-tone-N: suffixes, e.g. :+1::skin-tone-4:
+// Emojis that don't have aliases are left as they are.
+func Demojize(input string) string {
+	var output strings.Builder
+
+	scanEmojis(input, func(part, code string) {
+		output.WriteString(demojize(part, code))
+	})
+
+	return output.String()
+}
+
+// demojize returns the alias of the emoji code, or the part itself if it isn't found.
+func demojize(part, code string) string {
+	if code == "" {
+		return part
+	}
+
+	if alias, ok := FindAlias(code); ok {
+		return alias
+	}
+
+	if emoji, tones, ok := findEmojiWithTone(code); ok {
+		if alias, ok := FindAlias(emoji.String()); ok {
+			for _, t := range tones {
+				alias += toneAlias(t)
+			}
+
+			return alias
+		}
+	}
+
+	return part
+}
+
 // checkFlag finds flag emoji for `flag-[CODE]` pattern
 func checkFlag(alias string) string {
 	if matches := flagRegex.FindStringSubmatch(alias); len(matches) == 2 {
@@ -125,3 +437,41 @@ func checkFlag(alias string) string {
 
 	return ""
 }
+
+// aliasIndex returns the map of emoji codes and their aliases.
+// Aliases of an emoji are sorted by length, so the first one is the shortest alias.
+func aliasIndex() map[string][]string {
+	codeAliasesOnce.Do(func() {
+		codeAliases = make(map[string][]string)
+		for alias, code := range emojiMap {
+			codeAliases[code] = append(codeAliases[code], alias)
+		}
+
+		for _, aliases := range codeAliases {
+			sortAliases(aliases)
+		}
+	})
+
+	return codeAliases
+}
+
+// indexAlias adds the new alias to the alias index and the completion index, and its code to the emoji trie.
+func indexAlias(alias, code string) {
+	index := aliasIndex()
+	index[code] = append(index[code], alias)
+	sortAliases(index[code])
+
+	emojiTrie().insert(code)
+	completionIndex().insert(alias)
+}
+
+// sortAliases sorts aliases by length and alphabetically for the same lengths.
+func sortAliases(aliases []string) {
+	sort.Slice(aliases, func(i, j int) bool {
+		if len(aliases[i]) != len(aliases[j]) {
+			return len(aliases[i]) < len(aliases[j])
+		}
+
+		return aliases[i] < aliases[j]
+	})
+}
@@ -2,6 +2,7 @@ package emoji
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -76,6 +77,268 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParserMaxVersion(t *testing.T) {
+	tt := []struct {
+		parser   Parser
+		input    string
+		expected string
+	}{
+		{
+			parser:   Parser{},
+			input:    "new emojis :yawning_face: :smiling_face_with_tear:",
+			expected: fmt.Sprintf("new emojis %v %v", YawningFace, SmilingFaceWithTear),
+		},
+		{
+			parser:   Parser{MaxVersion: "12.0"},
+			input:    "new emojis :yawning_face: :smiling_face_with_tear:",
+			expected: fmt.Sprintf("new emojis %v :smiling_face_with_tear:", YawningFace),
+		},
+		{
+			parser:   Parser{MaxVersion: "11.0"},
+			input:    "new emojis :yawning_face::smiling_face_with_tear: :pizza:",
+			expected: fmt.Sprintf("new emojis :yawning_face::smiling_face_with_tear: %v", Pizza),
+		},
+		{
+			parser: Parser{
+				MaxVersion: "12.0",
+				Fallback: func(alias string) string {
+					return SmilingFace.String()
+				},
+			},
+			input:    "new emojis :yawning_face: :smiling_face_with_tear:",
+			expected: fmt.Sprintf("new emojis %v %v", YawningFace, SmilingFace),
+		},
+	}
+
+	for i, tc := range tt {
+		got := tc.parser.Parse(tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestParserLocales(t *testing.T) {
+	defer withLocaleAliases("de", map[string]string{
+		":daumen_hoch:": ThumbsUp.String(),
+	})()
+	defer withLocaleAliases("tr", map[string]string{
+		":roket:":  Rocket.String(),
+		":esneme:": YawningFace.String(),
+	})()
+
+	tt := []struct {
+		parser   Parser
+		input    string
+		expected string
+	}{
+		{
+			parser:   Parser{},
+			input:    "localized :daumen_hoch: :roket: :pizza:",
+			expected: fmt.Sprintf("localized :daumen_hoch: :roket: %v", Pizza),
+		},
+		{
+			parser:   Parser{Locales: []string{"de"}},
+			input:    "localized :daumen_hoch: :roket: :pizza:",
+			expected: fmt.Sprintf("localized %v :roket: %v", ThumbsUp, Pizza),
+		},
+		{
+			parser:   Parser{Locales: []string{"de", "tr"}},
+			input:    "localized :daumen_hoch: :roket: :pizza:",
+			expected: fmt.Sprintf("localized %v %v %v", ThumbsUp, Rocket, Pizza),
+		},
+		{
+			parser:   Parser{Locales: []string{"tr"}, MaxVersion: "11.0"},
+			input:    "localized :roket: :esneme:",
+			expected: fmt.Sprintf("localized %v :esneme:", Rocket),
+		},
+	}
+
+	for i, tc := range tt {
+		got := tc.parser.Parse(tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestParserUnknown(t *testing.T) {
+	parser := Parser{
+		Unknown: func(alias string) (string, bool) {
+			suggestions := Suggest(alias, 1)
+			if len(suggestions) == 0 {
+				return "", false
+			}
+
+			return Find(suggestions[0])
+		},
+	}
+
+	tt := []struct {
+		input    string
+		expected string
+	}{
+		{input: "ship it :rokcet:", expected: fmt.Sprintf("ship it %v", Rocket)},
+		{input: ":thumbsup_: :pizza:", expected: fmt.Sprintf("%v %v", ThumbsUp, Pizza)},
+		{input: "no match :xyzzy_qq:", expected: "no match :xyzzy_qq:"},
+	}
+
+	for i, tc := range tt {
+		got := parser.Parse(tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestParserContextAware(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "use `:tada:` for :tada:",
+			expected: fmt.Sprintf("use `:tada:` for %v", PartyPopper),
+		},
+		{
+			input:    "```\n:rocket:\n``` :rocket:",
+			expected: fmt.Sprintf("```\n:rocket:\n``` %v", Rocket),
+		},
+		{
+			input:    "see https://example.com/:100:/page :100:",
+			expected: fmt.Sprintf("see https://example.com/:100:/page %v", HundredPoints),
+		},
+		{
+			input:    "deployed at 10:100: and 12:30:45 :+1:",
+			expected: fmt.Sprintf("deployed at 10%v and 12:30:45 %v", HundredPoints, ThumbsUp),
+		},
+		{
+			input:    "unclosed ` :pizza:",
+			expected: fmt.Sprintf("unclosed ` %v", Pizza),
+		},
+		{
+			input:    "it's `a :tada:\n\nnext :tada: b` :tada:",
+			expected: fmt.Sprintf("it's `a %v\n\nnext %v b` %v", PartyPopper, PartyPopper, PartyPopper),
+		},
+	}
+
+	parser := Parser{ContextAware: true}
+	for i, tc := range tt {
+		got := parser.Parse(tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestParserToneSyntaxes(t *testing.T) {
+	tt := []struct {
+		syntaxes ToneSyntax
+		input    string
+		expected string
+	}{
+		{
+			input:    ":thumbsup::skin-tone-4: :+1_tone3:",
+			expected: ThumbsUp.Tone(Medium) + " " + ThumbsUp.Tone(Medium),
+		},
+		{
+			input:    ":people_holding_hands::skin-tone-2::skin-tone-6:",
+			expected: PeopleHoldingHands.Tone(Light, Dark),
+		},
+		{
+			input:    ":two_women_holding_hands::skin-tone-2::skin-tone-2:",
+			expected: "\U0001F46D\U0001F3FB",
+		},
+		{
+			input:    ":people_holding_hands_tone1_tone5:",
+			expected: PeopleHoldingHands.Tone(Light, Dark),
+		},
+		{
+			input:    ":+1::skin-tone-2::skin-tone-6:",
+			expected: ThumbsUp.Tone(Light) + ":skin-tone-6:",
+		},
+		{
+			input:    ":rocket::skin-tone-3: :rocket_tone3:",
+			expected: Rocket.String() + ":skin-tone-3: :rocket_tone3:",
+		},
+		{
+			input:    ":+1::skin-tone-7: :+1_tone6: :+1::skin-tone-3",
+			expected: ThumbsUp.String() + ":skin-tone-7: :+1_tone6: " + ThumbsUp.String() + ":skin-tone-3",
+		},
+		{
+			syntaxes: ToneSyntaxSlack,
+			input:    ":+1::skin-tone-3: :+1_tone3:",
+			expected: ThumbsUp.Tone(MediumLight) + " :+1_tone3:",
+		},
+		{
+			syntaxes: ToneSyntaxSuffix,
+			input:    ":+1::skin-tone-3: :+1_tone3:",
+			expected: ThumbsUp.String() + ":skin-tone-3: " + ThumbsUp.Tone(Medium),
+		},
+		{
+			syntaxes: ToneSyntaxNone,
+			input:    ":+1::skin-tone-3: :+1_tone3:",
+			expected: ThumbsUp.String() + ":skin-tone-3: :+1_tone3:",
+		},
+	}
+
+	for i, tc := range tt {
+		got := Parser{ToneSyntaxes: tc.syntaxes}.Parse(tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestParserToneSyntaxesUnknown(t *testing.T) {
+	var calls []string
+	parser := Parser{
+		Unknown: func(alias string) (string, bool) {
+			calls = append(calls, alias)
+
+			return "", false
+		},
+	}
+
+	tt := []struct {
+		input    string
+		expected string
+		calls    []string
+	}{
+		{input: ":foo_tone3:", expected: ":foo_tone3:", calls: []string{":foo_tone3:"}},
+		{input: ":rocket_tone3:", expected: ":rocket_tone3:", calls: []string{":rocket_tone3:"}},
+		{input: ":+1_tone3:", expected: ThumbsUp.Tone(Medium)},
+		{input: ":+1::skin-tone-3:", expected: ThumbsUp.Tone(MediumLight)},
+	}
+
+	for i, tc := range tt {
+		calls = nil
+
+		got := parser.Parse(tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+
+		if !reflect.DeepEqual(calls, tc.calls) {
+			t.Fatalf("test case %v fail: got calls: %q, expected: %q", i+1, calls, tc.calls)
+		}
+	}
+}
+
+func TestParseDemojizeTones(t *testing.T) {
+	tt := []string{
+		ThumbsUp.Tone(Dark),
+		PeopleHoldingHands.Tone(MediumLight, MediumDark),
+		"hi " + WavingHand.Tone(Light) + Rocket.String(),
+	}
+
+	for i, tc := range tt {
+		if got := Parse(Demojize(tc)); got != tc {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc)
+		}
+	}
+}
+
 func TestMap(t *testing.T) {
 	expected := len(emojiMap)
 	got := len(Map())
@@ -157,6 +420,72 @@ func TestFind(t *testing.T) {
 	}
 }
 
+func TestFindAlias(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected string
+		exist    bool
+	}{
+		{input: ThumbsUp.String(), expected: ":+1:", exist: true},
+		{input: Pizza.String(), expected: ":pizza:", exist: true},
+		{input: GrinningFace.String(), expected: ":grinning:", exist: true},
+		{input: FlagForTurkey.String(), expected: ":tr:", exist: true},
+		{input: "not emoji", expected: "", exist: false},
+	}
+
+	for i, tc := range tt {
+		got, exist := FindAlias(tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+
+		if exist != tc.exist {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, exist, tc.exist)
+		}
+	}
+}
+
+func TestAliases(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected []string
+	}{
+		{input: ThumbsUp.String(), expected: []string{":+1:", ":thumbsup:", ":thumbs_up:"}},
+		{input: Pizza.String(), expected: []string{":pizza:"}},
+		{input: "not emoji", expected: nil},
+	}
+
+	for i, tc := range tt {
+		got := Aliases(tc.input)
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestDemojize(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected string
+	}{
+		{input: "I like a " + Pizza.String(), expected: "I like a :pizza:"},
+		{input: Rocket.String() + Rocket.String() + "!", expected: ":rocket::rocket:!"},
+		{input: ThumbsUp.Tone(Medium), expected: ":+1::skin-tone-4:"},
+		{input: FlagForTurkey.String() + " " + Keycap1.String(), expected: ":tr: :one:"},
+		{input: "no emoji :pizza:", expected: "no emoji :pizza:"},
+		{input: "I \u2764 Go \u263a", expected: "I :heart: Go :relaxed:"},
+		{input: "text style © and \u2122", expected: "text style © and \u2122"},
+		{input: Parse(":man_technologist: :people_holding_hands:"), expected: ":man_technologist: :people_holding_hands:"},
+	}
+
+	for i, tc := range tt {
+		got := Demojize(tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
 func BenchmarkParse(b *testing.B) {
 	for n := 0; n < b.N; n++ {
 		_ = Parse("I am :man_technologist: from :flag_for_turkey:. Tests are :thumbs_up:")
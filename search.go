@@ -0,0 +1,178 @@
+package emoji
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Match scores of a search term
+const (
+	aliasPrefixScore = iota + 1
+	namePrefixScore
+	keywordPrefixScore
+	aliasScore
+	nameScore
+	keywordScore
+
+	// exactScore is the bonus for the query that is exactly the name or an alias of the emoji
+	exactScore = 10
+)
+
+// SearchResult defines an emoji that matches a search query.
+type SearchResult struct {
+	// Code is the unicode representation of the emoji
+	Code string
+	// Name is the CLDR short name of the emoji in the search locale
+	Name string
+	// Alias is the shortest alias of the emoji
+	Alias string
+	// Score is the relevance of the emoji for the query. Higher is better.
+	Score int
+}
+
+// Keywords returns CLDR annotation keywords of the emoji.
+func Keywords(code string) []string {
+	return emojiKeywords[code]
+}
+
+// Search returns emojis that match all words of the query by their keywords, names or aliases.
+// Results are ranked by relevance. Keyword matches rank higher than name matches,
+// and name matches rank higher than alias matches.
+func Search(query string) []SearchResult {
+	return search(query, emojiNames, emojiKeywords)
+}
+
+// SearchLocale returns emojis that match all words of the query by their keywords and names
+// in the given locale, or by their aliases. Results are ranked like Search.
+func SearchLocale(query, locale string) []SearchResult {
+	names, keywords, ok := localeData(locale)
+	if !ok {
+		return nil
+	}
+
+	return search(query, names, keywords)
+}
+
+// search returns emojis that match the query by given names, keywords and aliases.
+func search(query string, names map[string]string, keywords map[string][]string) []SearchResult {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	var results []SearchResult
+	for code, aliases := range aliasIndex() {
+		name, ok := names[code]
+		if !ok {
+			name = emojiNames[code]
+		}
+
+		score := searchScore(terms, names[code], keywords[code], aliases)
+		if score == 0 {
+			continue
+		}
+
+		results = append(results, SearchResult{
+			Code:  code,
+			Name:  name,
+			Alias: aliases[0],
+			Score: score,
+		})
+	}
+
+	sortResults(results)
+
+	return results
+}
+
+// searchScore returns the relevance of the emoji for the search terms.
+// It returns 0 if any of the terms doesn't match.
+func searchScore(terms []string, name string, keywords, aliases []string) int {
+	nameWords := searchTerms(name)
+	var keywordWords []string
+	for _, keyword := range keywords {
+		keywordWords = append(keywordWords, searchTerms(keyword)...)
+	}
+	var aliasWords []string
+	for _, alias := range aliases {
+		aliasWords = append(aliasWords, searchTerms(alias)...)
+	}
+
+	total := 0
+	for _, term := range terms {
+		score := maxScore(
+			wordScore(term, keywordWords, keywordScore, keywordPrefixScore),
+			wordScore(term, nameWords, nameScore, namePrefixScore),
+			wordScore(term, aliasWords, aliasScore, aliasPrefixScore),
+		)
+		if score == 0 {
+			return 0
+		}
+
+		total += score
+	}
+
+	if query := strings.Join(terms, " "); query == strings.Join(nameWords, " ") {
+		total += exactScore
+	} else {
+		for _, alias := range aliases {
+			if query == strings.Join(searchTerms(alias), " ") {
+				total += exactScore
+				break
+			}
+		}
+	}
+
+	return total
+}
+
+// wordScore returns the score of the term for the words.
+func wordScore(term string, words []string, exact, prefix int) int {
+	score := 0
+	for _, word := range words {
+		if word == term {
+			return exact
+		}
+
+		if strings.HasPrefix(word, term) {
+			score = prefix
+		}
+	}
+
+	return score
+}
+
+// searchTerms splits the text into lowercase words for searching.
+func searchTerms(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '+'
+	})
+}
+
+// sortResults sorts search results by score, then shorter names first.
+func sortResults(results []SearchResult) {
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if len(a.Name) != len(b.Name) {
+			return len(a.Name) < len(b.Name)
+		}
+
+		return a.Code < b.Code
+	})
+}
+
+// maxScore returns the maximum of the scores.
+func maxScore(scores ...int) int {
+	m := 0
+	for _, s := range scores {
+		if s > m {
+			m = s
+		}
+	}
+
+	return m
+}
@@ -0,0 +1,75 @@
+package emoji
+
+import (
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	tt := []struct {
+		query    string
+		expected string
+		alias    string
+	}{
+		{query: "pizza", expected: Pizza.String(), alias: ":pizza:"},
+		{query: "PIZZA", expected: Pizza.String(), alias: ":pizza:"},
+		{query: ":thumbs_up:", expected: ThumbsUp.String(), alias: ":+1:"},
+		{query: "technologist man", expected: ManTechnologist.String(), alias: ":man_technologist:"},
+		{query: "grinning face", expected: GrinningFace.String(), alias: ":grinning:"},
+		{query: "rock", expected: Rock.String(), alias: ":rock:"},
+	}
+
+	for i, tc := range tt {
+		results := Search(tc.query)
+		if len(results) == 0 {
+			t.Fatalf("test case %v fail: no result", i+1)
+		}
+
+		got := results[0]
+		if got.Code != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got.Code, tc.expected)
+		}
+
+		if got.Alias != tc.alias {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got.Alias, tc.alias)
+		}
+	}
+}
+
+func TestSearchNoResult(t *testing.T) {
+	tt := []string{"", "  ", "::", "not_exist_emoji", "pizza qwerty"}
+
+	for i, tc := range tt {
+		if got := Search(tc); len(got) != 0 {
+			t.Fatalf("test case %v fail: got: %v, expected no result", i+1, got)
+		}
+	}
+}
+
+func TestSearchKeywords(t *testing.T) {
+	code := Pizza.String()
+	keywords := emojiKeywords[code]
+	emojiKeywords[code] = []string{"cheese", "pizza", "slice"}
+	defer func() {
+		emojiKeywords[code] = keywords
+	}()
+
+	tt := []string{"slice", "cheese slice", "pizza slice"}
+
+	for i, tc := range tt {
+		results := Search(tc)
+		if len(results) == 0 || results[0].Code != code {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, results, code)
+		}
+	}
+
+	got := Keywords(code)
+	if len(got) != 3 {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, emojiKeywords[code])
+	}
+}
+
+func BenchmarkSearch(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_ = Search("face")
+	}
+}
@@ -0,0 +1,253 @@
+package emoji
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	// variationSelector is the emoji presentation selector.
+	variationSelector = '\ufe0f'
+	// zeroWidthJoiner joins emojis into emoji sequences.
+	zeroWidthJoiner = '\u200d'
+)
+
+var (
+	// codeTrie is the trie of all known emoji codes.
+	// It's built from the emoji names and aliases on first use.
+	codeTrie     *trieNode
+	codeTrieOnce sync.Once
+)
+
+// trieNode is a node of the emoji code trie.
+// code is the full emoji code if the node is the end of an emoji.
+type trieNode struct {
+	children map[rune]*trieNode
+	code     string
+}
+
+// insert adds the emoji code to the trie.
+func (n *trieNode) insert(code string) {
+	node := n
+	for _, r := range code {
+		child, ok := node.children[r]
+		if !ok {
+			child = &trieNode{children: make(map[rune]*trieNode)}
+			node.children[r] = child
+		}
+		node = child
+	}
+	node.code = code
+}
+
+// match returns the longest emoji code at the beginning of the text and its length in the text.
+// Variation selectors are optional in the text except the one after a single text symbol,
+// so unqualified emoji sequences and pictographs like ❤ are matched too, but text style characters like © are not.
+func (n *trieNode) match(text string) (string, int) {
+	return n.matchDepth(text, 0)
+}
+
+// matchDepth matches the text from the node that is at the depth of the trie.
+func (n *trieNode) matchDepth(text string, depth int) (string, int) {
+	code, size := n.code, 0
+
+	r, width := utf8.DecodeRuneInString(text)
+	if child, ok := n.children[r]; ok && text != "" {
+		if c, s := child.matchDepth(text[width:], depth+1); c != "" {
+			return c, s + width
+		}
+	}
+
+	// variation selector is missing in the text
+	if child, ok := n.children[variationSelector]; ok && r != variationSelector {
+		if c, s := child.matchDepth(text, depth+1); c != "" && (s > 0 || depth > 1 || isPictograph(c)) {
+			return c, s
+		}
+	}
+
+	// variation selector is redundant in the text
+	if code != "" && r == variationSelector {
+		size += width
+	}
+
+	return code, size
+}
+
+// isPictograph checks whether the code starts with a pictograph of Miscellaneous Symbols, Dingbats
+// or the emoji blocks, e.g. ❤ and ☺. They are emojis without variation selectors in practice,
+// unlike text symbols like ©, ™ and arrows.
+func isPictograph(code string) bool {
+	r, _ := utf8.DecodeRuneInString(code)
+
+	return (r >= 0x2600 && r <= 0x27bf) || (r >= 0x1f000 && r <= 0x1faff)
+}
+
+// emojiTrie returns the trie of all known emoji codes.
+func emojiTrie() *trieNode {
+	codeTrieOnce.Do(func() {
+		codeTrie = &trieNode{children: make(map[rune]*trieNode)}
+		for code := range emojiNames {
+			codeTrie.insert(code)
+		}
+
+		for code := range aliasIndex() {
+			codeTrie.insert(code)
+		}
+	})
+
+	return codeTrie
+}
+
+// scanEmojis splits the text into emojis and other texts, and calls fn for each part in order.
+// code is the emoji code for emojis and empty for other texts.
+func scanEmojis(text string, fn func(part, code string)) {
+	trie := emojiTrie()
+
+	start := 0
+	for i := 0; i < len(text); {
+		code, size := trie.match(text[i:])
+		if code == "" {
+			_, width := utf8.DecodeRuneInString(text[i:])
+			i += width
+			continue
+		}
+
+		if start < i {
+			fn(text[start:i], "")
+		}
+		fn(text[i:i+size], code)
+
+		i += size
+		start = i
+	}
+
+	if start < len(text) {
+		fn(text[start:], "")
+	}
+}
+
+// EmojiCount returns the count of emojis in the text.
+// Emoji sequences like 👨‍👩‍👧, 👍🏽 and 🇹🇷 count as one emoji.
+func EmojiCount(s string) int {
+	count, _ := countEmojis(s)
+
+	return count
+}
+
+// IsOnlyEmoji checks whether the text consists of emojis and whitespace only,
+// e.g. for enlarging emoji-only messages of chats. Texts without emojis aren't.
+func IsOnlyEmoji(s string) bool {
+	return IsOnlyEmojiN(s, 0)
+}
+
+// IsOnlyEmojiN checks whether the text consists of at most n emojis and whitespace only like IsOnlyEmoji.
+// The count of emojis isn't limited if n is not positive.
+func IsOnlyEmojiN(s string, n int) bool {
+	count, only := countEmojis(s)
+
+	return only && count > 0 && (n <= 0 || count <= n)
+}
+
+// countEmojis returns the count of emojis in the text, and whether the text has nothing but emojis and whitespace.
+func countEmojis(s string) (int, bool) {
+	count, only := 0, true
+	scanEmojis(s, func(part, code string) {
+		// components like skin tones aren't emojis alone
+		if code != "" && !isComponent(code) {
+			count++
+		} else if strings.TrimSpace(part) != "" {
+			only = false
+		}
+	})
+
+	return count, only
+}
+
+// IsRGI checks whether the emoji is recommended for general interchange (RGI),
+// that is a fully-qualified emoji in the Unicode emoji list. Components like skin tones aren't RGI alone.
+func IsRGI(code string) bool {
+	if _, ok := emojiNames[code]; !ok {
+		return false
+	}
+
+	return !isComponent(code)
+}
+
+// isComponent checks whether the emoji is a component like skin tones and hair styles.
+func isComponent(code string) bool {
+	group, _, _ := GroupOf(code)
+
+	return group == componentGroup
+}
+
+// componentGroup is the group of emoji components like skin tones and hair styles.
+const componentGroup = "Component"
+
+// SequenceBuilder composes emoji ZWJ sequences like families and couples from their components:
+//
+//	var b SequenceBuilder
+//	b.Add(Woman).Add(Woman).Add(Girl).Add(Boy).Build() // 👩‍👩‍👧‍👦
+//	b.Reset()
+//	b.Add(Woman, Medium).Add(Handshake).Add(Man, Dark).Build() // 👩🏽‍🤝‍👨🏿
+//
+// Build validates the sequence, so sequences that are rendered as separate emojis are never returned.
+// The zero value is an empty builder.
+type SequenceBuilder struct {
+	components []string
+	err        error
+}
+
+// Add appends the emoji with the skin tones to the sequence.
+func (b *SequenceBuilder) Add(emoji fmt.Stringer, tones ...Tone) *SequenceBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	code := emoji.String()
+	if len(tones) > 0 {
+		e, _, ok := findEmojiWithTone(code)
+		if !ok {
+			b.err = fmt.Errorf("emoji doesn't have skin tone options: %+q", code)
+			return b
+		}
+		toned, err := e.CheckedTone(tones...)
+		if err != nil {
+			b.err = err
+			return b
+		}
+		code = toned
+	}
+
+	b.components = append(b.components, code)
+
+	return b
+}
+
+// Reset empties the sequence.
+func (b *SequenceBuilder) Reset() {
+	*b = SequenceBuilder{}
+}
+
+// Build joins the components with zero width joiners and returns the fully-qualified emoji sequence.
+// It returns an error if the sequence isn't recommended for general interchange.
+func (b *SequenceBuilder) Build() (string, error) {
+	if b.err != nil {
+		return "", b.err
+	}
+
+	if len(b.components) == 0 {
+		return "", fmt.Errorf("emoji sequence is empty")
+	}
+
+	sequence := strings.Join(b.components, string(zeroWidthJoiner))
+
+	// variation selectors of the components are optional, the trie matches the fully-qualified sequence
+	code, size := emojiTrie().match(sequence)
+	if size != len(sequence) || !IsRGI(code) {
+		return "", fmt.Errorf("emoji sequence is not RGI: %+q", sequence)
+	}
+
+	return code, nil
+}
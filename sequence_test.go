@@ -0,0 +1,227 @@
+package emoji
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScanEmojis(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected []string
+	}{
+		{input: "", expected: nil},
+		{input: "no emoji", expected: []string{"no emoji"}},
+		{input: "\U0001F680", expected: []string{"[\U0001F680]"}},
+		{
+			input:    "I am \U0001F468\u200d\U0001F4BB from \U0001F1F9\U0001F1F7!",
+			expected: []string{"I am ", "[\U0001F468\u200d\U0001F4BB]", " from ", "[\U0001F1F9\U0001F1F7]", "!"},
+		},
+		{
+			input:    "\U0001F44D\U0001F3FD\U0001F44B",
+			expected: []string{"[\U0001F44D\U0001F3FD]", "[\U0001F44B]"},
+		},
+		{
+			input:    "unqualified \U0001F441\u200d\U0001F5E8 eye",
+			expected: []string{"unqualified ", "[\U0001F441\ufe0f\u200d\U0001F5E8\ufe0f]", " eye"},
+		},
+		{
+			input:    "redundant \U0001F680\ufe0f selector",
+			expected: []string{"redundant ", "[\U0001F680]", " selector"},
+		},
+		{
+			input:    "text style © and 1 # *",
+			expected: []string{"text style © and 1 # *"},
+		},
+		{
+			input:    "I \u2764 Go \u263a\u270c",
+			expected: []string{"I ", "[\u2764\ufe0f]", " Go ", "[\u263a\ufe0f]", "[\u270c\ufe0f]"},
+		},
+		{
+			input:    "keycap 1\ufe0f\u20e3 ©\ufe0f",
+			expected: []string{"keycap ", "[1\ufe0f\u20e3]", " ", "[©\ufe0f]"},
+		},
+	}
+
+	for i, tc := range tt {
+		var got []string
+		scanEmojis(tc.input, func(part, code string) {
+			if code != "" {
+				part = "[" + code + "]"
+			}
+			got = append(got, part)
+		})
+
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Fatalf("test case %v fail: got: %q, expected: %q", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestEmojiCount(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected int
+	}{
+		{input: "", expected: 0},
+		{input: "no emoji 123", expected: 0},
+		{input: "\U0001F680 to the moon \U0001F680", expected: 2},
+		{input: FamilyManWomanGirl.String() + ThumbsUp.Tone(Medium) + "\U0001F1F9\U0001F1F7", expected: 3},
+		{input: "keycap 1\ufe0f\u20e3 and © 1", expected: 1},
+		{input: "\u2764 \u2764", expected: 2},
+		{input: "\U0001F3FD", expected: 0},
+		{input: "\U0001F44D\U0001F3FD\U0001F3FD", expected: 1},
+	}
+
+	for i, tc := range tt {
+		if got := EmojiCount(tc.input); got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestIsOnlyEmoji(t *testing.T) {
+	tt := []struct {
+		input    string
+		n        int
+		expected bool
+	}{
+		{input: "", expected: false},
+		{input: " \n\t", expected: false},
+		{input: "\U0001F680", expected: true},
+		{input: " \U0001F680 \n" + PeopleHoldingHands.Tone(Light, Dark) + "\U0001F1F9\U0001F1F7\t", expected: true},
+		{input: "\U0001F680!", expected: false},
+		{input: "ok \U0001F44D", expected: false},
+		{input: "\U0001F468\u200d\U0001F4BB", expected: true},
+		{input: "\U0001F441\u200d\U0001F5E8", expected: true},
+		{input: "©", expected: false},
+		{input: "\u2764", expected: true},
+		{input: "\u263a \u270c\u2764\ufe0f", expected: true},
+		{input: "\U0001F3FD", expected: false},
+		{input: "\U0001F44D \U0001F3FD", expected: false},
+		{input: "\U0001F680\U0001F680\U0001F680", n: 3, expected: true},
+		{input: "\U0001F680 \U0001F680 \U0001F680 \U0001F680", n: 3, expected: false},
+		{input: "\U0001F680 \U0001F680 \U0001F680 \U0001F680", n: -1, expected: true},
+	}
+
+	for i, tc := range tt {
+		got := IsOnlyEmojiN(tc.input, tc.n)
+		if tc.n == 0 && IsOnlyEmoji(tc.input) != got {
+			t.Fatalf("test case %v fail: IsOnlyEmoji and IsOnlyEmojiN differ", i+1)
+		}
+
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestIsRGI(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected bool
+	}{
+		{input: Rocket.String(), expected: true},
+		{input: ThumbsUp.Tone(Dark), expected: true},
+		{input: FamilyWomanWomanGirlBoy.String(), expected: true},
+		{input: Copyright.String(), expected: true},
+		{input: "©", expected: false},
+		{input: Dark.String(), expected: false},
+		{input: RedHair.String(), expected: false},
+		{input: "\U0001F469\u200d\U0001F680\u200d\U0001F355", expected: false},
+		{input: "not emoji", expected: false},
+	}
+
+	for i, tc := range tt {
+		if got := IsRGI(tc.input); got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestSequenceBuilder(t *testing.T) {
+	tt := []struct {
+		build    func(b *SequenceBuilder) *SequenceBuilder
+		expected string
+	}{
+		{
+			build: func(b *SequenceBuilder) *SequenceBuilder {
+				return b.Add(Woman).Add(Woman).Add(Girl).Add(Boy)
+			},
+			expected: FamilyWomanWomanGirlBoy.String(),
+		},
+		{
+			build: func(b *SequenceBuilder) *SequenceBuilder {
+				return b.Add(Woman).Add(Emoji("❤")).Add(Man)
+			},
+			expected: CoupleWithHeartWomanMan.String(),
+		},
+		{
+			build: func(b *SequenceBuilder) *SequenceBuilder {
+				return b.Add(Woman, Medium).Add(Handshake).Add(Man, Dark)
+			},
+			expected: WomanAndManHoldingHands.Tone(Medium, Dark),
+		},
+		{
+			build: func(b *SequenceBuilder) *SequenceBuilder {
+				return b.Add(Rocket)
+			},
+			expected: Rocket.String(),
+		},
+	}
+
+	for i, tc := range tt {
+		var b SequenceBuilder
+		got, err := tc.build(&b).Build()
+		if err != nil {
+			t.Fatalf("test case %v fail: %v", i+1, err)
+		}
+
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %+q, expected: %+q", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestSequenceBuilderError(t *testing.T) {
+	tt := []func(b *SequenceBuilder) *SequenceBuilder{
+		func(b *SequenceBuilder) *SequenceBuilder {
+			return b
+		},
+		func(b *SequenceBuilder) *SequenceBuilder {
+			return b.Add(Woman, Medium).Add(Woman, Medium).Add(Girl, Medium).Add(Boy, Medium)
+		},
+		func(b *SequenceBuilder) *SequenceBuilder {
+			return b.Add(Woman).Add(Rocket).Add(Pizza)
+		},
+		func(b *SequenceBuilder) *SequenceBuilder {
+			return b.Add(Rocket, Dark).Add(Woman)
+		},
+		func(b *SequenceBuilder) *SequenceBuilder {
+			return b.Add(Woman).Add(Woman).Add(Girl).Add(Boy).Add(Boy)
+		},
+		func(b *SequenceBuilder) *SequenceBuilder {
+			return b.Add(Woman, Medium, Dark, Light)
+		},
+	}
+
+	for i, build := range tt {
+		var b SequenceBuilder
+		if got, err := build(&b).Build(); err == nil {
+			t.Fatalf("test case %v fail: got: %+q, expected error", i+1, got)
+		}
+	}
+}
+
+func TestSequenceBuilderReset(t *testing.T) {
+	var b SequenceBuilder
+	if _, err := b.Add(Rocket, Dark).Build(); err == nil {
+		t.Fatalf("test case fail: expected error")
+	}
+
+	b.Reset()
+	got, err := b.Add(Man).Add(Man).Add(Boy).Build()
+	if err != nil || got != FamilyManManBoy.String() {
+		t.Fatalf("test case fail: got: %+q, %v, expected: %+q", got, err, FamilyManManBoy)
+	}
+}
@@ -0,0 +1,233 @@
+package emoji
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// TonedEmoji defines an emoji with skin tone options that is rendered with specific skin tones.
+// It's useful for storing emojis with skin tones, e.g. user reactions.
+type TonedEmoji struct {
+	Emoji EmojiWithTone
+	Tones []Tone
+}
+
+// String returns string representation of the emoji with its skin tones.
+func (e TonedEmoji) String() string {
+	return e.Emoji.Tone(e.Tones...)
+}
+
+// MarshalText implements encoding.TextMarshaler for the toned emoji.
+// The emoji is marshaled with its skin tones, which are validated like CheckedTone.
+func (e TonedEmoji) MarshalText() ([]byte, error) {
+	code, err := e.checked()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(code), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for the toned emoji.
+// It accepts the unicode representation of the emoji with skin tones or an alias with :skin-tone-N: suffixes.
+// More skin tones than the emoji takes return an error like CheckedTone.
+func (e *TonedEmoji) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*e = TonedEmoji{}
+		return nil
+	}
+
+	alias, tones := splitToneAliases(string(text))
+	code, err := unmarshalCode(alias)
+	if err != nil {
+		return err
+	}
+
+	emoji, codeTones, ok := findEmojiWithTone(code)
+	if !ok {
+		return fmt.Errorf("emoji doesn't have skin tone options: %q", text)
+	}
+
+	if len(tones) == 0 {
+		tones = codeTones
+	}
+
+	if _, err = emoji.CheckedTone(tones...); err != nil {
+		return err
+	}
+
+	*e = TonedEmoji{Emoji: emoji, Tones: tones}
+
+	return nil
+}
+
+// Value implements driver.Valuer for the toned emoji.
+func (e TonedEmoji) Value() (driver.Value, error) {
+	code, err := e.checked()
+	if err != nil {
+		return nil, err
+	}
+
+	return code, nil
+}
+
+// checked returns the unicode representation of the emoji with its skin tones, or an error like CheckedTone.
+func (e TonedEmoji) checked() (string, error) {
+	return e.Emoji.CheckedTone(e.Tones...)
+}
+
+// Scan implements sql.Scanner for the toned emoji.
+func (e *TonedEmoji) Scan(src interface{}) error {
+	text, err := scanText(src)
+	if err != nil {
+		return err
+	}
+
+	return e.UnmarshalText([]byte(text))
+}
+
+// AliasTonedEmoji is a toned emoji that is stored and marshaled with its shortest alias,
+// and its skin tones as :skin-tone-N: suffixes, e.g. :+1::skin-tone-4:
+// It's useful for databases that can't store 4-byte UTF-8 characters, e.g. MySQL utf8 columns.
+type AliasTonedEmoji TonedEmoji
+
+// String returns string representation of the emoji with its skin tones.
+func (e AliasTonedEmoji) String() string {
+	return TonedEmoji(e).String()
+}
+
+// MarshalText implements encoding.TextMarshaler for the alias toned emoji.
+func (e AliasTonedEmoji) MarshalText() ([]byte, error) {
+	alias, err := e.alias()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(alias), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler for the alias toned emoji.
+// It accepts the same representations as TonedEmoji.
+func (e *AliasTonedEmoji) UnmarshalText(text []byte) error {
+	return (*TonedEmoji)(e).UnmarshalText(text)
+}
+
+// Value implements driver.Valuer for the alias toned emoji.
+func (e AliasTonedEmoji) Value() (driver.Value, error) {
+	alias, err := e.alias()
+	if err != nil {
+		return nil, err
+	}
+
+	return alias, nil
+}
+
+// Scan implements sql.Scanner for the alias toned emoji.
+func (e *AliasTonedEmoji) Scan(src interface{}) error {
+	return (*TonedEmoji)(e).Scan(src)
+}
+
+// alias returns the alias of the emoji with :skin-tone-N: suffixes like Demojize.
+// The unicode representation is returned if the emoji doesn't have an alias.
+func (e AliasTonedEmoji) alias() (string, error) {
+	code, err := TonedEmoji(e).checked()
+	if err != nil {
+		return "", err
+	}
+
+	return demojize(code, code), nil
+}
+
+// Value implements driver.Valuer for the simple emoji.
+func (e Emoji) Value() (driver.Value, error) {
+	return e.String(), nil
+}
+
+// Scan implements sql.Scanner for the simple emoji.
+func (e *Emoji) Scan(src interface{}) error {
+	text, err := scanText(src)
+	if err != nil {
+		return err
+	}
+
+	return e.UnmarshalText([]byte(text))
+}
+
+// Value implements driver.Valuer for the alias emoji.
+func (e AliasEmoji) Value() (driver.Value, error) {
+	return aliasCode(e.String()), nil
+}
+
+// Scan implements sql.Scanner for the alias emoji.
+func (e *AliasEmoji) Scan(src interface{}) error {
+	return (*Emoji)(e).Scan(src)
+}
+
+// Value implements driver.Valuer for the skin tone.
+// The skin tone is stored with its name, e.g. "medium-dark".
+func (t Tone) Value() (driver.Value, error) {
+	name, err := t.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return string(name), nil
+}
+
+// Scan implements sql.Scanner for the skin tone.
+func (t *Tone) Scan(src interface{}) error {
+	text, err := scanText(src)
+	if err != nil {
+		return err
+	}
+
+	return t.UnmarshalText([]byte(text))
+}
+
+// scanText returns the text of the database value.
+// NULL is scanned as empty text.
+func scanText(src interface{}) (string, error) {
+	switch v := src.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	default:
+		return "", fmt.Errorf("not valid emoji value type: %T", src)
+	}
+}
+
+// toneAlias returns the :skin-tone-N: alias of the skin tone.
+// Skin tones are numbered from 2 (light) to 6 (dark) as Fitzpatrick scale does.
+func toneAlias(t Tone) string {
+	for i, tone := range skinTones {
+		if tone == t {
+			return fmt.Sprintf(":skin-tone-%d:", i+2)
+		}
+	}
+
+	return ""
+}
+
+// splitToneAliases splits the alias and its :skin-tone-N: suffixes.
+func splitToneAliases(text string) (string, []Tone) {
+	var tones []Tone
+	for {
+		matched := false
+		for _, t := range skinTones {
+			if suffix := toneAlias(t); strings.HasSuffix(text, suffix) && len(text) > len(suffix) {
+				text = strings.TrimSuffix(text, suffix)
+				tones = append([]Tone{t}, tones...)
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return text, tones
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package emoji
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// fakeDriver is a database driver that stores inserted values in memory.
+// Every query returns the last inserted row.
+type fakeDriver struct {
+	row []driver.Value
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{driver: d}, nil
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{driver: c.driver}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeStmt struct {
+	driver *fakeDriver
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.driver.row = args
+
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{row: s.driver.row}, nil
+}
+
+type fakeRows struct {
+	row  []driver.Value
+	done bool
+}
+
+func (r *fakeRows) Columns() []string {
+	return make([]string, len(r.row))
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.row)
+
+	return nil
+}
+
+var fake = &fakeDriver{}
+
+func init() {
+	sql.Register("emoji_fake", fake)
+}
+
+func TestSQL(t *testing.T) {
+	db, err := sql.Open("emoji_fake", "")
+	if err != nil {
+		t.Fatalf("could not open database: %v", err)
+	}
+	defer db.Close()
+
+	tt := []struct {
+		emoji      Emoji
+		alias      AliasEmoji
+		tone       Tone
+		toned      TonedEmoji
+		aliasToned AliasTonedEmoji
+		stored     []driver.Value
+	}{
+		{
+			emoji:      Rocket,
+			alias:      AliasEmoji(Rocket),
+			tone:       MediumDark,
+			toned:      TonedEmoji{Emoji: ThumbsUp, Tones: []Tone{Medium}},
+			aliasToned: AliasTonedEmoji{Emoji: ThumbsUp, Tones: []Tone{Medium}},
+			stored:     []driver.Value{"\U0001F680", ":rocket:", "medium-dark", "\U0001F44D\U0001F3FD", ":+1::skin-tone-4:"},
+		},
+		{
+			emoji:      FlagForTurkey,
+			alias:      AliasEmoji(FlagForTurkey),
+			tone:       Light,
+			toned:      TonedEmoji{Emoji: PeopleHoldingHands, Tones: []Tone{Light, Dark}},
+			aliasToned: AliasTonedEmoji{Emoji: PeopleHoldingHands, Tones: []Tone{Light, Dark}},
+			stored: []driver.Value{
+				"\U0001F1F9\U0001F1F7", ":tr:", "light",
+				PeopleHoldingHands.Tone(Light, Dark), ":people_holding_hands::skin-tone-2::skin-tone-6:",
+			},
+		},
+		{
+			emoji:      Rocket,
+			alias:      AliasEmoji("custom"),
+			tone:       Default,
+			toned:      TonedEmoji{Emoji: WavingHand},
+			aliasToned: AliasTonedEmoji{Emoji: WavingHand},
+			stored:     []driver.Value{"\U0001F680", "custom", "default", "\U0001F44B", ":wave:"},
+		},
+	}
+
+	for i, tc := range tt {
+		if _, err := db.Exec("INSERT", tc.emoji, tc.alias, tc.tone, tc.toned, tc.aliasToned); err != nil {
+			t.Fatalf("test case %v fail: %v", i+1, err)
+		}
+
+		if !reflect.DeepEqual(fake.row, tc.stored) {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, fake.row, tc.stored)
+		}
+
+		if tc.alias == "custom" {
+			continue
+		}
+
+		var emoji Emoji
+		var alias AliasEmoji
+		var tone Tone
+		var toned TonedEmoji
+		var aliasToned AliasTonedEmoji
+		if err := db.QueryRow("SELECT").Scan(&emoji, &alias, &tone, &toned, &aliasToned); err != nil {
+			t.Fatalf("test case %v fail: %v", i+1, err)
+		}
+
+		if emoji != tc.emoji || alias != tc.alias || tone != tc.tone ||
+			toned.String() != tc.toned.String() || aliasToned.String() != tc.aliasToned.String() {
+			t.Fatalf("test case %v fail: got: %v %v %v %v %v, expected: %v %v %v %v %v",
+				i+1, emoji, alias, tone, toned, aliasToned, tc.emoji, tc.alias, tc.tone, tc.toned, tc.aliasToned)
+		}
+	}
+}
+
+func TestSQLScanNull(t *testing.T) {
+	emoji := Rocket
+	if err := emoji.Scan(nil); err != nil || emoji != "" {
+		t.Fatalf("test case fail: got: %v, %v", emoji, err)
+	}
+
+	toned := TonedEmoji{Emoji: ThumbsUp, Tones: []Tone{Dark}}
+	if err := toned.Scan(nil); err != nil || toned.String() != "" {
+		t.Fatalf("test case fail: got: %v, %v", toned, err)
+	}
+}
+
+func TestSQLScanUnqualified(t *testing.T) {
+	var emoji Emoji
+	if err := emoji.Scan([]byte("\u2764")); err != nil || emoji != RedHeart {
+		t.Fatalf("test case fail: got: %+q, %v, expected: %+q", emoji, err, RedHeart)
+	}
+
+	var toned TonedEmoji
+	if err := toned.Scan("\u270c\U0001F3FD"); err != nil || toned.String() != VictoryHand.Tone(Medium) {
+		t.Fatalf("test case fail: got: %+q, %v, expected: %+q", toned, err, VictoryHand.Tone(Medium))
+	}
+}
+
+func TestSQLValueError(t *testing.T) {
+	tt := []driver.Valuer{
+		TonedEmoji{Emoji: ThumbsUp, Tones: []Tone{Medium, Dark, Light}},
+		AliasTonedEmoji{Emoji: ThumbsUp, Tones: []Tone{Medium, Dark}},
+		AliasTonedEmoji{Emoji: PeopleHoldingHands, Tones: []Tone{Default, Dark}},
+	}
+
+	for i, tc := range tt {
+		if v, err := tc.Value(); err == nil {
+			t.Fatalf("test case %v fail: expected error, got: %v", i+1, v)
+		}
+
+		if m, ok := tc.(encoding.TextMarshaler); ok {
+			if text, err := m.MarshalText(); err == nil {
+				t.Fatalf("test case %v fail: expected error, got: %s", i+1, text)
+			}
+		}
+	}
+}
+
+func TestSQLScanError(t *testing.T) {
+	var emoji Emoji
+	var tone Tone
+	var toned TonedEmoji
+	var alias AliasEmoji
+	var aliasToned AliasTonedEmoji
+
+	tt := []struct {
+		scanner sql.Scanner
+		src     interface{}
+	}{
+		{scanner: &emoji, src: 42},
+		{scanner: &emoji, src: "not emoji"},
+		{scanner: &tone, src: []byte("purple")},
+		{scanner: &toned, src: ":rocket:"},
+		{scanner: &toned, src: ":not_exist_emoji::skin-tone-2:"},
+		{scanner: &toned, src: ":+1::skin-tone-4::skin-tone-5::skin-tone-6:"},
+		{scanner: &toned, src: ":people_holding_hands::skin-tone-2::skin-tone-3::skin-tone-4:"},
+		{scanner: &aliasToned, src: ":+1::skin-tone-4::skin-tone-5:"},
+		{scanner: &alias, src: "not emoji"},
+		{scanner: &aliasToned, src: ":rocket:"},
+	}
+
+	for i, tc := range tt {
+		if err := tc.scanner.Scan(tc.src); err == nil {
+			t.Fatalf("test case %v fail: expected error", i+1)
+		}
+	}
+}
+
+func TestTonedEmoji(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected TonedEmoji
+	}{
+		{input: "\U0001F44D", expected: TonedEmoji{Emoji: ThumbsUp}},
+		{input: "\U0001F44D\U0001F3FF", expected: TonedEmoji{Emoji: ThumbsUp, Tones: []Tone{Dark}}},
+		{input: ":thumbsup::skin-tone-3:", expected: TonedEmoji{Emoji: ThumbsUp, Tones: []Tone{MediumLight}}},
+		{
+			input:    "\U0001f469\U0001F3FD\u200d\U0001f91d\u200d\U0001f468\U0001F3FF",
+			expected: TonedEmoji{Emoji: WomanAndManHoldingHands, Tones: []Tone{Medium, Dark}},
+		},
+	}
+
+	for i, tc := range tt {
+		var got TonedEmoji
+		if err := got.UnmarshalText([]byte(tc.input)); err != nil {
+			t.Fatalf("test case %v fail: %v", i+1, err)
+		}
+
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestAliasTonedEmoji(t *testing.T) {
+	tt := []struct {
+		input    AliasTonedEmoji
+		expected string
+	}{
+		{input: AliasTonedEmoji{Emoji: ThumbsUp, Tones: []Tone{Dark}}, expected: `":+1::skin-tone-6:"`},
+		{input: AliasTonedEmoji{Emoji: WavingHand, Tones: []Tone{Default}}, expected: `":wave:"`},
+		{input: AliasTonedEmoji{}, expected: `""`},
+	}
+
+	for i, tc := range tt {
+		got, err := json.Marshal(tc.input)
+		if err != nil || string(got) != tc.expected {
+			t.Fatalf("test case %v fail: got: %s, %v, expected: %v", i+1, got, err, tc.expected)
+		}
+
+		var parsed AliasTonedEmoji
+		if err = json.Unmarshal(got, &parsed); err != nil || parsed.String() != tc.input.String() {
+			t.Fatalf("test case %v fail: got: %v, %v, expected: %v", i+1, parsed, err, tc.input)
+		}
+	}
+}
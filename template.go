@@ -0,0 +1,101 @@
+package emoji
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+)
+
+// TemplateFuncs returns the emoji functions for text/template.
+//
+//	{{ emoji "tada" }}             returns the emoji of the alias
+//	{{ emojify .Body }}            replaces emoji aliases in the text
+//	{{ flag .Country }}            returns the country flag of ISO 3166 Alpha2 code
+//	{{ tone "thumbs_up" "dark" }}  returns the emoji of the alias with skin tones
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"emoji":   templateEmoji,
+		"emojify": Parse,
+		"flag":    templateFlag,
+		"tone":    templateTone,
+	}
+}
+
+// HTMLTemplateFuncs returns the emoji functions for html/template.
+// They are the same with TemplateFuncs, but they escape their output,
+// so emojify is safe to use with user content.
+func HTMLTemplateFuncs() htmltemplate.FuncMap {
+	return htmltemplate.FuncMap{
+		"emoji":   escapeFunc(templateEmoji),
+		"emojify": escapeFunc(func(text string) (string, error) { return Parse(text), nil }),
+		"flag":    escapeFunc(templateFlag),
+		"tone": func(alias string, tones ...string) (htmltemplate.HTML, error) {
+			return escapeHTML(templateTone(alias, tones...))
+		},
+	}
+}
+
+// templateEmoji returns the emoji of the alias. Colons of the alias are optional.
+func templateEmoji(alias string) (string, error) {
+	if !strings.HasPrefix(alias, ":") {
+		alias = ":" + alias + ":"
+	}
+
+	code, ok := Find(alias)
+	if !ok {
+		return "", fmt.Errorf("emoji not found: %q", alias)
+	}
+
+	return code, nil
+}
+
+// templateFlag returns the country flag of the code.
+func templateFlag(code string) (string, error) {
+	flag, err := CountryFlag(code)
+	if err != nil {
+		return "", err
+	}
+
+	return flag.String(), nil
+}
+
+// templateTone returns the emoji of the alias with the skin tones.
+// Skin tones are given by their names, e.g. "medium-dark", and they are validated like CheckedTone.
+func templateTone(alias string, names ...string) (string, error) {
+	code, err := templateEmoji(alias)
+	if err != nil {
+		return "", err
+	}
+
+	emoji, _, ok := findEmojiWithTone(code)
+	if !ok {
+		return "", fmt.Errorf("emoji doesn't have skin tone options: %q", alias)
+	}
+
+	tones := make([]Tone, len(names))
+	for i, name := range names {
+		if err = tones[i].UnmarshalText([]byte(name)); err != nil {
+			return "", err
+		}
+	}
+
+	return emoji.CheckedTone(tones...)
+}
+
+// escapeFunc wraps the template function to escape its output for HTML.
+func escapeFunc(fn func(string) (string, error)) func(string) (htmltemplate.HTML, error) {
+	return func(s string) (htmltemplate.HTML, error) {
+		return escapeHTML(fn(s))
+	}
+}
+
+// escapeHTML escapes the text for HTML.
+// Emoji characters are not changed by escaping.
+func escapeHTML(text string, err error) (htmltemplate.HTML, error) {
+	if err != nil {
+		return "", err
+	}
+
+	return htmltemplate.HTML(htmltemplate.HTMLEscapeString(text)), nil
+}
@@ -0,0 +1,89 @@
+package emoji
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"testing"
+	"text/template"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	tt := []struct {
+		tmpl     string
+		data     interface{}
+		expected string
+	}{
+		{tmpl: `{{ emoji "tada" }}`, expected: PartyPopper.String()},
+		{tmpl: `{{ emoji ":+1:" }}`, expected: ThumbsUp.String()},
+		{tmpl: `{{ emojify . }}`, data: "<b>:tada:</b>", expected: fmt.Sprintf("<b>%v</b>", PartyPopper)},
+		{tmpl: `{{ flag . }}`, data: "tr", expected: FlagForTurkey.String()},
+		{tmpl: `{{ tone "thumbs_up" "dark" }}`, expected: ThumbsUp.Tone(Dark)},
+		{tmpl: `{{ tone "thumbs_up" }}`, expected: ThumbsUp.String()},
+		{tmpl: `{{ tone "people_holding_hands" "light" "dark" }}`, expected: PeopleHoldingHands.Tone(Light, Dark)},
+	}
+
+	for i, tc := range tt {
+		tmpl := template.Must(template.New("test").Funcs(TemplateFuncs()).Parse(tc.tmpl))
+
+		var got bytes.Buffer
+		if err := tmpl.Execute(&got, tc.data); err != nil {
+			t.Fatalf("test case %v fail: %v", i+1, err)
+		}
+
+		if got.String() != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got.String(), tc.expected)
+		}
+	}
+}
+
+func TestHTMLTemplateFuncs(t *testing.T) {
+	tt := []struct {
+		tmpl     string
+		data     interface{}
+		expected string
+	}{
+		{tmpl: `<p>{{ emoji "tada" }}</p>`, expected: fmt.Sprintf("<p>%v</p>", PartyPopper)},
+		{
+			tmpl:     `<p>{{ emojify . }}</p>`,
+			data:     `<script>alert(":tada:")</script>`,
+			expected: fmt.Sprintf("<p>&lt;script&gt;alert(&#34;%v&#34;)&lt;/script&gt;</p>", PartyPopper),
+		},
+		{tmpl: `<p>{{ flag . }}</p>`, data: "TR", expected: fmt.Sprintf("<p>%v</p>", FlagForTurkey)},
+		{tmpl: `<p>{{ tone "wave" "medium" }}</p>`, expected: fmt.Sprintf("<p>%v</p>", WavingHand.Tone(Medium))},
+	}
+
+	for i, tc := range tt {
+		tmpl := htmltemplate.Must(htmltemplate.New("test").Funcs(HTMLTemplateFuncs()).Parse(tc.tmpl))
+
+		var got bytes.Buffer
+		if err := tmpl.Execute(&got, tc.data); err != nil {
+			t.Fatalf("test case %v fail: %v", i+1, err)
+		}
+
+		if got.String() != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got.String(), tc.expected)
+		}
+	}
+}
+
+func TestTemplateFuncsError(t *testing.T) {
+	tt := []string{
+		`{{ emoji "not_exist_emoji" }}`,
+		`{{ flag "tur" }}`,
+		`{{ tone "rocket" "dark" }}`,
+		`{{ tone "thumbs_up" "purple" }}`,
+		`{{ tone "not_exist_emoji" "dark" }}`,
+		`{{ tone "thumbs_up" "dark" "light" }}`,
+		`{{ tone "people_holding_hands" "default" "dark" }}`,
+	}
+
+	for i, tc := range tt {
+		tmpl := template.Must(template.New("test").Funcs(TemplateFuncs()).Parse(tc))
+
+		var got bytes.Buffer
+		if err := tmpl.Execute(&got, nil); err == nil {
+			t.Fatalf("test case %v fail: expected error, got: %v", i+1, got.String())
+		}
+	}
+}
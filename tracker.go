@@ -0,0 +1,316 @@
+package emoji
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Default options of trackers
+const (
+	// DefaultTrackerCapacity is the number of emojis that trackers keep by default.
+	DefaultTrackerCapacity = 36
+	// DefaultTrackerHalfLife is the duration that usage scores are halved in by default.
+	DefaultTrackerHalfLife = 7 * 24 * time.Hour
+)
+
+// Tracker ranks frequently and recently used emojis, e.g. for the "frequently used" row of emoji pickers.
+// Every usage adds 1 to the score of the emoji, and scores decay by half in every half-life,
+// so recent usages count more. Emojis with skin tones are tracked as their basic emojis.
+//
+// Tracker is safe for concurrent use. The zero value is a tracker with default options.
+// Trackers can be persisted with encoding/json.
+type Tracker struct {
+	mu       sync.Mutex
+	capacity int
+	halfLife time.Duration
+	usages   map[string]usage
+	// now returns the current time, it's replaced in tests
+	now func() time.Time
+}
+
+// usage is the decaying usage score of an emoji.
+type usage struct {
+	score   float64
+	updated time.Time
+}
+
+// trackedEmoji is the JSON representation of an emoji usage.
+type trackedEmoji struct {
+	Code    string    `json:"code"`
+	Score   float64   `json:"score"`
+	Updated time.Time `json:"updated"`
+}
+
+// trackerJSON is the JSON representation of the tracker.
+type trackerJSON struct {
+	Capacity int            `json:"capacity"`
+	HalfLife string         `json:"half_life"`
+	Emojis   []trackedEmoji `json:"emojis"`
+}
+
+// NewTracker returns a tracker that keeps at most capacity emojis and halves their scores in every halfLife.
+// Default options are used for non-positive values.
+func NewTracker(capacity int, halfLife time.Duration) *Tracker {
+	return &Tracker{capacity: capacity, halfLife: halfLife}
+}
+
+// Record adds a usage of the emoji. Emojis with skin tones are recorded as their basic emojis.
+// The least used emoji is dropped if the tracker is full.
+func (t *Tracker) Record(code string) {
+	if code == "" {
+		return
+	}
+
+	if emoji, _, ok := findEmojiWithTone(code); ok {
+		code = emoji.String()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.usages == nil {
+		t.usages = make(map[string]usage)
+	}
+
+	now := t.clock()
+	t.usages[code] = usage{score: t.score(t.usages[code], now) + 1, updated: now}
+
+	// the recorded emoji is kept, so new emojis can get into a full tracker of frequently used ones
+	t.trim(now, code)
+}
+
+// Top returns at most n emojis by their usage scores, or all of them if n is not positive.
+func (t *Tracker) Top(n int) []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var codes []string
+	for _, e := range t.ranked(t.clock()) {
+		codes = append(codes, e.Code)
+		if len(codes) == n {
+			break
+		}
+	}
+
+	return codes
+}
+
+// Score returns the current usage score of the emoji.
+func (t *Tracker) Score(code string) float64 {
+	if emoji, _, ok := findEmojiWithTone(code); ok {
+		code = emoji.String()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.score(t.usages[code], t.clock())
+}
+
+// Complete returns alias candidates for autocomplete like Complete,
+// but candidates of frequently used emojis come first.
+// For an empty prefix, e.g. just after ":" is typed, the most used emojis are returned.
+func (t *Tracker) Complete(prefix string, limit int) []Candidate {
+	if completionName(prefix) == "" {
+		t.mu.Lock()
+		emojis := t.ranked(t.clock())
+		t.mu.Unlock()
+
+		var candidates []Candidate
+		for _, e := range emojis {
+			alias, ok := FindAlias(e.Code)
+			if !ok {
+				continue
+			}
+
+			candidates = append(candidates, Candidate{
+				Alias:     alias,
+				Code:      e.Code,
+				Canonical: alias,
+				Score:     int(math.Ceil(e.Score)),
+			})
+			if len(candidates) == limit {
+				break
+			}
+		}
+
+		return candidates
+	}
+
+	candidates := Complete(prefix, 0)
+	scores := make([]float64, len(candidates))
+	for i, c := range candidates {
+		scores[i] = t.Score(c.Code)
+	}
+
+	sort.Stable(byUsage{candidates: candidates, scores: scores})
+
+	if limit > 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	return candidates
+}
+
+// MarshalJSON implements json.Marshaler for the tracker.
+func (t *Tracker) MarshalJSON() ([]byte, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// scores are stored at their update times, so they keep decaying after unmarshaling
+	emojis := t.ranked(t.clock())
+	for i := range emojis {
+		emojis[i].Score = t.usages[emojis[i].Code].score
+	}
+	if emojis == nil {
+		emojis = []trackedEmoji{}
+	}
+
+	return json.Marshal(trackerJSON{
+		Capacity: t.limit(),
+		HalfLife: t.period().String(),
+		Emojis:   emojis,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler for the tracker.
+func (t *Tracker) UnmarshalJSON(data []byte) error {
+	var v trackerJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	var halfLife time.Duration
+	if v.HalfLife != "" {
+		d, err := time.ParseDuration(v.HalfLife)
+		if err != nil {
+			return fmt.Errorf("not valid tracker half-life: %q", v.HalfLife)
+		}
+		halfLife = d
+	}
+
+	usages := make(map[string]usage)
+	for _, e := range v.Emojis {
+		usages[e.Code] = usage{score: e.Score, updated: e.Updated}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.capacity = v.Capacity
+	t.halfLife = halfLife
+	t.usages = usages
+
+	t.trim(t.clock(), "")
+
+	return nil
+}
+
+// ranked returns the tracked emojis ranked by their scores at the time.
+// Recently used emojis come first for the same scores.
+func (t *Tracker) ranked(now time.Time) []trackedEmoji {
+	var emojis []trackedEmoji
+	for code, u := range t.usages {
+		emojis = append(emojis, trackedEmoji{Code: code, Score: t.score(u, now), Updated: u.updated})
+	}
+
+	sort.Slice(emojis, func(i, j int) bool {
+		a, b := emojis[i], emojis[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if !a.Updated.Equal(b.Updated) {
+			return a.Updated.After(b.Updated)
+		}
+
+		return a.Code < b.Code
+	})
+
+	return emojis
+}
+
+// trim removes the emojis with the lowest scores at the time if the tracker has more emojis than its capacity.
+// The emoji of the keep code isn't removed.
+func (t *Tracker) trim(now time.Time, keep string) {
+	if len(t.usages) <= t.limit() {
+		return
+	}
+
+	limit := t.limit()
+	var others []trackedEmoji
+	for _, e := range t.ranked(now) {
+		if e.Code == keep {
+			limit--
+			continue
+		}
+		others = append(others, e)
+	}
+
+	for _, e := range others[limit:] {
+		delete(t.usages, e.Code)
+	}
+}
+
+// score returns the decayed score of the usage at the time.
+func (t *Tracker) score(u usage, now time.Time) float64 {
+	if u.score == 0 {
+		return 0
+	}
+
+	elapsed := now.Sub(u.updated)
+	if elapsed <= 0 {
+		return u.score
+	}
+
+	return u.score * math.Exp2(-float64(elapsed)/float64(t.period()))
+}
+
+// limit returns the capacity of the tracker.
+func (t *Tracker) limit() int {
+	if t.capacity <= 0 {
+		return DefaultTrackerCapacity
+	}
+
+	return t.capacity
+}
+
+// period returns the half-life of the tracker.
+func (t *Tracker) period() time.Duration {
+	if t.halfLife <= 0 {
+		return DefaultTrackerHalfLife
+	}
+
+	return t.halfLife
+}
+
+// clock returns the current time of the tracker.
+func (t *Tracker) clock() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+
+	return time.Now()
+}
+
+// byUsage sorts candidates by usage scores of their emojis.
+type byUsage struct {
+	candidates []Candidate
+	scores     []float64
+}
+
+func (s byUsage) Len() int {
+	return len(s.candidates)
+}
+
+func (s byUsage) Less(i, j int) bool {
+	return s.scores[i] > s.scores[j]
+}
+
+func (s byUsage) Swap(i, j int) {
+	s.candidates[i], s.candidates[j] = s.candidates[j], s.candidates[i]
+	s.scores[i], s.scores[j] = s.scores[j], s.scores[i]
+}
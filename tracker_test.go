@@ -0,0 +1,183 @@
+package emoji
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestTracker returns a tracker with a clock that can be moved forward.
+func newTestTracker(capacity int, halfLife time.Duration) (*Tracker, func(time.Duration)) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	tracker := NewTracker(capacity, halfLife)
+	tracker.now = func() time.Time { return now }
+
+	return tracker, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestTracker(t *testing.T) {
+	tracker, wait := newTestTracker(3, time.Hour)
+
+	tracker.Record(Rocket.String())
+	tracker.Record(ThumbsUp.Tone(Dark))
+	tracker.Record(ThumbsUp.String())
+	tracker.Record(ThumbsUp.Tone(Light))
+	wait(2 * time.Hour)
+	tracker.Record(Pizza.String())
+	tracker.Record(Pizza.String())
+
+	// thumbs up: 3 usages 2 half-lives ago, pizza: 2 recent usages
+	expected := []string{Pizza.String(), ThumbsUp.String(), Rocket.String()}
+	if got := tracker.Top(0); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, expected)
+	}
+
+	if got := tracker.Score(ThumbsUp.Tone(Medium)); got != 0.75 {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, 0.75)
+	}
+
+	// rocket has the lowest score, so it's dropped for the new emoji
+	tracker.Record(PartyPopper.String())
+	expected = []string{Pizza.String(), PartyPopper.String()}
+	if got := tracker.Top(2); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, expected)
+	}
+
+	if got := tracker.Score(Rocket.String()); got != 0 {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, 0)
+	}
+}
+
+func TestTrackerSaturated(t *testing.T) {
+	tracker, wait := newTestTracker(3, 24*time.Hour)
+
+	for _, e := range []Emoji{Pizza, Fire, Rocket} {
+		for i := 0; i < 5; i++ {
+			tracker.Record(e.String())
+		}
+	}
+
+	// the new emoji gets in although the others have higher scores
+	tracker.Record(PartyPopper.String())
+	if got := tracker.Score(PartyPopper.String()); got != 1 {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, 1)
+	}
+
+	expected := []string{Pizza.String(), Fire.String(), PartyPopper.String()}
+	if got := tracker.Top(0); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, expected)
+	}
+
+	for i := 0; i < 9; i++ {
+		wait(time.Hour)
+		tracker.Record(PartyPopper.String())
+	}
+
+	if got := tracker.Top(1); !reflect.DeepEqual(got, []string{PartyPopper.String()}) {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, PartyPopper)
+	}
+}
+
+func TestTrackerZeroValue(t *testing.T) {
+	var tracker Tracker
+	for i := 0; i < DefaultTrackerCapacity+10; i++ {
+		tracker.Record(string(rune(0x1F600 + i)))
+	}
+	tracker.Record("")
+
+	if got := len(tracker.Top(0)); got != DefaultTrackerCapacity {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, DefaultTrackerCapacity)
+	}
+}
+
+func TestTrackerConcurrency(t *testing.T) {
+	tracker := NewTracker(10, time.Hour)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				tracker.Record(Rocket.String())
+				_ = tracker.Top(3)
+				_ = tracker.Complete(":ro", 3)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := tracker.Score(Rocket.String()); math.Abs(got-1000) > 1 {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, 1000)
+	}
+}
+
+func TestTrackerJSON(t *testing.T) {
+	tracker, wait := newTestTracker(5, 24*time.Hour)
+	tracker.Record(Rocket.String())
+	tracker.Record(Rocket.String())
+	tracker.Record(Pizza.String())
+	wait(24 * time.Hour)
+
+	b, err := json.Marshal(tracker)
+	if err != nil {
+		t.Fatalf("test case fail: %v", err)
+	}
+
+	expected := `{"capacity":5,"half_life":"24h0m0s","emojis":[` +
+		"{\"code\":\"\U0001F680\",\"score\":2,\"updated\":\"2020-06-01T12:00:00Z\"}," +
+		"{\"code\":\"\U0001F355\",\"score\":1,\"updated\":\"2020-06-01T12:00:00Z\"}]}"
+	if string(b) != expected {
+		t.Fatalf("test case fail: got: %s, expected: %s", b, expected)
+	}
+
+	restored, _ := newTestTracker(0, 0)
+	if err = json.Unmarshal(b, restored); err != nil {
+		t.Fatalf("test case fail: %v", err)
+	}
+
+	if got := restored.Top(0); !reflect.DeepEqual(got, tracker.Top(0)) {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, tracker.Top(0))
+	}
+
+	if got := restored.Score(Rocket.String()); got != 2 {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, 2)
+	}
+
+	if err = json.Unmarshal([]byte(`{"half_life":"a week"}`), restored); err == nil {
+		t.Fatalf("test case fail: expected error")
+	}
+}
+
+func TestTrackerComplete(t *testing.T) {
+	tracker, _ := newTestTracker(10, time.Hour)
+	tracker.Record(ThumbsDown.String())
+	tracker.Record(Rocket.String())
+	tracker.Record(Rocket.String())
+
+	tt := []struct {
+		input    string
+		limit    int
+		expected []string
+	}{
+		{input: ":", limit: 0, expected: []string{":rocket:", ":-1:"}},
+		{input: "", limit: 1, expected: []string{":rocket:"}},
+		{input: ":thumbs", limit: 0, expected: []string{":thumbsdown:", ":thumbsup:"}},
+		{input: ":thumbs", limit: 1, expected: []string{":thumbsdown:"}},
+		{input: ":piz", limit: 5, expected: []string{":pizza:"}},
+	}
+
+	for i, tc := range tt {
+		var got []string
+		for _, c := range tracker.Complete(tc.input, tc.limit) {
+			got = append(got, c.Alias)
+		}
+
+		if !reflect.DeepEqual(got, tc.expected) {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
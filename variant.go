@@ -0,0 +1,144 @@
+package emoji
+
+import (
+	"sync"
+)
+
+// Gender defines gender options of person emojis.
+type Gender int
+
+// Gender options
+const (
+	GenderNeutral Gender = iota
+	GenderMan
+	GenderWoman
+)
+
+// Hair defines hair style options of person emojis.
+type Hair int
+
+// Hair style options
+const (
+	HairDefault Hair = iota
+	HairRed
+	HairCurly
+	HairWhite
+	HairBald
+	HairBlond
+	HairBeard
+)
+
+var (
+	genderNames = map[Gender]string{
+		GenderNeutral: "neutral",
+		GenderMan:     "man",
+		GenderWoman:   "woman",
+	}
+
+	hairNames = map[Hair]string{
+		HairDefault: "default",
+		HairRed:     "red",
+		HairCurly:   "curly",
+		HairWhite:   "white",
+		HairBald:    "bald",
+		HairBlond:   "blond",
+		HairBeard:   "beard",
+	}
+
+	// familyVariants is the map of variant families, genders and hair styles, and the emoji codes.
+	// It's built from the person variants on first use.
+	familyVariants     map[personVariant]string
+	familyVariantsOnce sync.Once
+)
+
+// personVariant defines the gender and hair style of an emoji in its variant family.
+type personVariant struct {
+	family string
+	gender Gender
+	hair   Hair
+}
+
+// String returns the name of the gender, e.g. "woman".
+func (g Gender) String() string {
+	return genderNames[g]
+}
+
+// String returns the name of the hair style, e.g. "curly".
+func (h Hair) String() string {
+	return hairNames[h]
+}
+
+// VariantOf returns the gender and hair style of the person emoji, e.g. GenderWoman and HairRed for 👩‍🦰.
+func VariantOf(code string) (Gender, Hair, bool) {
+	v, _, ok := findPersonVariant(code)
+
+	return v.gender, v.hair, ok
+}
+
+// WithGender returns the gender variant of the person emoji, e.g. 👩‍💻 for 🧑‍💻 and GenderWoman.
+// Hair style and skin tones of the emoji are kept. It reports false if the emoji doesn't have the variant.
+func WithGender(code string, gender Gender) (string, bool) {
+	v, tones, ok := findPersonVariant(code)
+	if !ok {
+		return "", false
+	}
+
+	v.gender = gender
+
+	return variantCode(v, tones)
+}
+
+// WithHair returns the hair style variant of the person emoji, e.g. 👨‍🦱 for 👨 and HairCurly.
+// Gender and skin tones of the emoji are kept. It reports false if the emoji doesn't have the variant.
+func WithHair(code string, hair Hair) (string, bool) {
+	v, tones, ok := findPersonVariant(code)
+	if !ok {
+		return "", false
+	}
+
+	v.hair = hair
+
+	return variantCode(v, tones)
+}
+
+// findPersonVariant returns the variant and the skin tones of the person emoji.
+func findPersonVariant(code string) (personVariant, []Tone, bool) {
+	if v, ok := personVariants[code]; ok {
+		return v, nil, true
+	}
+
+	emoji, tones, ok := findEmojiWithTone(code)
+	if !ok {
+		return personVariant{}, nil, false
+	}
+
+	v, ok := personVariants[emoji.String()]
+
+	return v, tones, ok
+}
+
+// variantCode returns the emoji code of the variant with the skin tones.
+func variantCode(v personVariant, tones []Tone) (string, bool) {
+	familyVariantsOnce.Do(func() {
+		familyVariants = make(map[personVariant]string)
+		for code, v := range personVariants {
+			familyVariants[v] = code
+		}
+	})
+
+	code, ok := familyVariants[v]
+	if !ok {
+		return "", false
+	}
+
+	if len(tones) == 0 {
+		return code, true
+	}
+
+	emoji, ok := emojiWithTones[code]
+	if !ok {
+		return "", false
+	}
+
+	return emoji.Tone(tones...), true
+}
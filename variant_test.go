@@ -0,0 +1,87 @@
+package emoji
+
+import (
+	"testing"
+)
+
+func TestWithGender(t *testing.T) {
+	tt := []struct {
+		input    string
+		gender   Gender
+		expected string
+		exist    bool
+	}{
+		{input: Technologist.String(), gender: GenderWoman, expected: WomanTechnologist.String(), exist: true},
+		{input: ManTechnologist.String(), gender: GenderNeutral, expected: Technologist.String(), exist: true},
+		{input: PersonFrowning.String(), gender: GenderMan, expected: ManFrowning.String(), exist: true},
+		{input: ManWithRedHair.String(), gender: GenderWoman, expected: WomanWithRedHair.String(), exist: true},
+		{input: WomanTechnologist.Tone(Medium), gender: GenderMan, expected: ManTechnologist.Tone(Medium), exist: true},
+		{input: OlderPerson.String(), gender: GenderWoman, expected: OldWoman.String(), exist: true},
+		{input: ManDancing.String(), gender: GenderNeutral, expected: "", exist: false},
+		{input: Rocket.String(), gender: GenderMan, expected: "", exist: false},
+	}
+
+	for i, tc := range tt {
+		got, exist := WithGender(tc.input, tc.gender)
+		if got != tc.expected || exist != tc.exist {
+			t.Fatalf("test case %v fail: got: %v, %v, expected: %v, %v", i+1, got, exist, tc.expected, tc.exist)
+		}
+	}
+}
+
+func TestWithHair(t *testing.T) {
+	tt := []struct {
+		input    string
+		hair     Hair
+		expected string
+		exist    bool
+	}{
+		{input: Person.String(), hair: HairRed, expected: PersonWithRedHair.String(), exist: true},
+		{input: Man.String(), hair: HairCurly, expected: ManWithCurlyHair.String(), exist: true},
+		{input: WomanBald.String(), hair: HairDefault, expected: Woman.String(), exist: true},
+		{input: Man.String(), hair: HairBeard, expected: ManWithBeard.String(), exist: true},
+		{input: Woman.Tone(Dark), hair: HairBlond, expected: WomanWithBlondHair.Tone(Dark), exist: true},
+		{input: Technologist.String(), hair: HairRed, expected: "", exist: false},
+		{input: Rocket.String(), hair: HairRed, expected: "", exist: false},
+	}
+
+	for i, tc := range tt {
+		got, exist := WithHair(tc.input, tc.hair)
+		if got != tc.expected || exist != tc.exist {
+			t.Fatalf("test case %v fail: got: %v, %v, expected: %v, %v", i+1, got, exist, tc.expected, tc.exist)
+		}
+	}
+}
+
+func TestVariantOf(t *testing.T) {
+	tt := []struct {
+		input  string
+		gender Gender
+		hair   Hair
+		exist  bool
+	}{
+		{input: Technologist.String(), gender: GenderNeutral, hair: HairDefault, exist: true},
+		{input: WomanWithRedHair.String(), gender: GenderWoman, hair: HairRed, exist: true},
+		{input: ManBald.Tone(Light), gender: GenderMan, hair: HairBald, exist: true},
+		{input: PersonWithBlondHair.String(), gender: GenderNeutral, hair: HairBlond, exist: true},
+		{input: Rocket.String(), gender: GenderNeutral, hair: HairDefault, exist: false},
+	}
+
+	for i, tc := range tt {
+		gender, hair, exist := VariantOf(tc.input)
+		if gender != tc.gender || hair != tc.hair || exist != tc.exist {
+			t.Fatalf("test case %v fail: got: %v, %v, %v, expected: %v, %v, %v",
+				i+1, gender, hair, exist, tc.gender, tc.hair, tc.exist)
+		}
+	}
+}
+
+func TestGenderHairString(t *testing.T) {
+	if got := GenderWoman.String(); got != "woman" {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, "woman")
+	}
+
+	if got := HairCurly.String(); got != "curly" {
+		t.Fatalf("test case fail: got: %v, expected: %v", got, "curly")
+	}
+}
@@ -0,0 +1,55 @@
+package emoji
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Version returns the Unicode Emoji version that the emoji is introduced in.
+func Version(code string) (string, bool) {
+	version, ok := emojiVersions[code]
+
+	return version, ok
+}
+
+// Supported checks whether the emoji is available in the given Unicode Emoji version, e.g. "12.0".
+// Emojis that are unknown to the package, like custom aliases, are considered as supported.
+func Supported(code, version string) bool {
+	introduced, ok := Version(code)
+	if !ok {
+		return true
+	}
+
+	return compareVersions(introduced, version) <= 0
+}
+
+// compareVersions compares two "major.minor" versions.
+// The result is 0 if a == b, -1 if a < b, and +1 if a > b.
+func compareVersions(a, b string) int {
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		x, y := versionPart(as, i), versionPart(bs, i)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// versionPart returns the numeric value of i-th part of the version.
+// Missing or not valid parts are considered as 0.
+func versionPart(parts []string, i int) int {
+	if i >= len(parts) {
+		return 0
+	}
+
+	n, _ := strconv.Atoi(parts[i])
+
+	return n
+}
@@ -0,0 +1,76 @@
+package emoji
+
+import (
+	"testing"
+)
+
+func TestVersion(t *testing.T) {
+	tt := []struct {
+		input    string
+		expected string
+		exist    bool
+	}{
+		{input: GrinningFace.String(), expected: "1.0", exist: true},
+		{input: YawningFace.String(), expected: "12.0", exist: true},
+		{input: SmilingFaceWithTear.String(), expected: "13.0", exist: true},
+		{input: WavingHand.Tone(Dark), expected: "1.0", exist: true},
+		{input: FlagForTurkey.String(), expected: "2.0", exist: true},
+		{input: "not emoji", expected: "", exist: false},
+	}
+
+	for i, tc := range tt {
+		got, exist := Version(tc.input)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+
+		if exist != tc.exist {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, exist, tc.exist)
+		}
+	}
+}
+
+func TestSupported(t *testing.T) {
+	tt := []struct {
+		code     string
+		version  string
+		expected bool
+	}{
+		{code: GrinningFace.String(), version: "12.0", expected: true},
+		{code: YawningFace.String(), version: "12.0", expected: true},
+		{code: YawningFace.String(), version: "11.0", expected: false},
+		{code: SmilingFaceWithTear.String(), version: "12.1", expected: false},
+		{code: SmilingFaceWithTear.String(), version: "13", expected: true},
+		{code: SmilingFaceWithTear.String(), version: "13.1", expected: true},
+		{code: "not emoji", version: "1.0", expected: true},
+	}
+
+	for i, tc := range tt {
+		got := Supported(tc.code, tc.version)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tt := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "12.0", b: "12.0", expected: 0},
+		{a: "12", b: "12.0", expected: 0},
+		{a: "12.0", b: "12.1", expected: -1},
+		{a: "13.0", b: "12.1", expected: 1},
+		{a: "0.6", b: "0.7", expected: -1},
+		{a: "11.0", b: "2.0", expected: 1},
+	}
+
+	for i, tc := range tt {
+		got := compareVersions(tc.a, tc.b)
+		if got != tc.expected {
+			t.Fatalf("test case %v fail: got: %v, expected: %v", i+1, got, tc.expected)
+		}
+	}
+}